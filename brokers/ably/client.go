@@ -0,0 +1,161 @@
+package ably
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ably/ably-go/ably"
+	"github.com/cobbinma/track-api/brokers"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/rs/zerolog/log"
+	"sync"
+)
+
+const (
+	messageName         = "JourneyUpdate"
+	crossingMessageName = "GeofenceCrossing"
+)
+
+var _ brokers.Broker = (*Client)(nil)
+
+type Client struct {
+	realtime *ably.Realtime
+}
+
+func NewAbly(key string) (*Client, error) {
+	realtime, err := ably.NewRealtime(ably.WithKey(key))
+	if err != nil {
+		return nil, fmt.Errorf("new realtime : %w", err)
+	}
+
+	return &Client{realtime: realtime}, nil
+}
+
+func (c *Client) Publish(ctx context.Context, journeyID string, journey *model.Journey) error {
+	message, err := json.Marshal(journey)
+	if err != nil {
+		return fmt.Errorf("marshal : %w", err)
+	}
+
+	if err := c.realtime.Channels.Get(journeyID).Publish(ctx, messageName, string(message)); err != nil {
+		return fmt.Errorf("publish : %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) Subscribe(ctx context.Context, journeyID string) (<-chan *model.Journey, error) {
+	ch := make(chan *model.Journey, 1)
+
+	// the mutex stops messages still being delivered by ably from sending on the closed channel
+	var mu sync.Mutex
+	closed := false
+
+	unsubscribe, err := c.realtime.Channels.Get(journeyID).SubscribeAll(ctx, func(msg *ably.Message) {
+		data, ok := msg.Data.(string)
+		if !ok {
+			log.Error().Msgf("unsupported message type: %T", msg.Data)
+			return
+		}
+
+		var j = &model.Journey{}
+		if err := json.Unmarshal([]byte(data), j); err != nil {
+			log.Error().Err(err).Msg("unable to unmarshal message")
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return
+		}
+
+		select {
+		case ch <- j:
+		case <-ctx.Done():
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("subscribe all : %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+
+		mu.Lock()
+		defer mu.Unlock()
+		closed = true
+		close(ch)
+	}()
+
+	return ch, nil
+}
+
+func (c *Client) PublishGeofenceCrossing(ctx context.Context, userID string, crossing *model.GeofenceCrossing) error {
+	message, err := json.Marshal(crossing)
+	if err != nil {
+		return fmt.Errorf("marshal : %w", err)
+	}
+
+	if err := c.realtime.Channels.Get(crossingsChannel(userID)).Publish(ctx, crossingMessageName,
+		string(message)); err != nil {
+		return fmt.Errorf("publish : %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) SubscribeGeofenceCrossings(ctx context.Context, userID string) (<-chan *model.GeofenceCrossing, error) {
+	ch := make(chan *model.GeofenceCrossing, 1)
+
+	// the mutex stops messages still being delivered by ably from sending on the closed channel
+	var mu sync.Mutex
+	closed := false
+
+	unsubscribe, err := c.realtime.Channels.Get(crossingsChannel(userID)).SubscribeAll(ctx, func(msg *ably.Message) {
+		data, ok := msg.Data.(string)
+		if !ok {
+			log.Error().Msgf("unsupported message type: %T", msg.Data)
+			return
+		}
+
+		var crossing = &model.GeofenceCrossing{}
+		if err := json.Unmarshal([]byte(data), crossing); err != nil {
+			log.Error().Err(err).Msg("unable to unmarshal message")
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return
+		}
+
+		select {
+		case ch <- crossing:
+		case <-ctx.Done():
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("subscribe all : %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+
+		mu.Lock()
+		defer mu.Unlock()
+		closed = true
+		close(ch)
+	}()
+
+	return ch, nil
+}
+
+// crossingsChannel returns the channel of crossings of the user's geofences, kept apart from journey channels which
+// are named by journey id.
+func crossingsChannel(userID string) string {
+	return "geofences:" + userID
+}
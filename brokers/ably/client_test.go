@@ -0,0 +1,51 @@
+package ably
+
+import (
+	"context"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/google/uuid"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestClient publishes through the Ably account in TEST_ABLY_API_KEY. It is skipped when none is given.
+func TestClient(t *testing.T) {
+	key := os.Getenv("TEST_ABLY_API_KEY")
+	if key == "" {
+		t.Skip("TEST_ABLY_API_KEY not given")
+	}
+
+	broker, err := NewAbly(key)
+	if err != nil {
+		t.Fatalf("unable to connect to ably: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	journeyID := uuid.New().String()
+	ch, err := broker.Subscribe(ctx, journeyID)
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	journey := &model.Journey{ID: journeyID, User: &model.User{ID: "user"}, Status: model.JourneyStatusActive,
+		Position: &model.Position{Lat: 51.5, Lng: -0.12}}
+	if err := broker.Publish(ctx, journeyID, journey); err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+
+	select {
+	case j := <-ch:
+		if j.ID != journeyID || j.Position == nil || j.Position.Lat != 51.5 || j.Position.Lng != -0.12 {
+			t.Errorf("expected %+v, got %+v", journey, j)
+		}
+	case <-ctx.Done():
+		t.Fatal("expected the published journey, got none")
+	}
+
+	cancel()
+	for range ch {
+	}
+}
@@ -0,0 +1,19 @@
+package brokers
+
+import (
+	"context"
+	"github.com/cobbinma/track-api/graph/model"
+)
+
+// Broker delivers journey updates and geofence crossings to subscribers in realtime.
+type Broker interface {
+	// Publish sends the journey to every subscriber of the journey id.
+	Publish(ctx context.Context, journeyID string, journey *model.Journey) error
+	// Subscribe returns a channel of updates to the journey which is closed once ctx is done.
+	Subscribe(ctx context.Context, journeyID string) (<-chan *model.Journey, error)
+	// PublishGeofenceCrossing sends the crossing to every subscriber of the user's geofences.
+	PublishGeofenceCrossing(ctx context.Context, userID string, crossing *model.GeofenceCrossing) error
+	// SubscribeGeofenceCrossings returns a channel of crossings of the user's geofences which is closed once ctx is
+	// done.
+	SubscribeGeofenceCrossings(ctx context.Context, userID string) (<-chan *model.GeofenceCrossing, error)
+}
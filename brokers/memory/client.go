@@ -0,0 +1,153 @@
+package memory
+
+import (
+	"context"
+	"github.com/cobbinma/track-api/brokers"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/rs/zerolog/log"
+	"sync"
+)
+
+var _ brokers.Broker = (*Client)(nil)
+
+// subscriberBuffer is how many messages a subscriber may fall behind by before it is disconnected.
+const subscriberBuffer = 16
+
+type subscriber struct {
+	ch   chan *model.Journey
+	once sync.Once
+}
+
+func (s *subscriber) close() {
+	s.once.Do(func() { close(s.ch) })
+}
+
+type crossingSubscriber struct {
+	ch   chan *model.GeofenceCrossing
+	once sync.Once
+}
+
+func (s *crossingSubscriber) close() {
+	s.once.Do(func() { close(s.ch) })
+}
+
+// Client fans journey updates and geofence crossings out to subscribers within the same process. Publishing never
+// waits for a subscriber, one that has fallen too far behind is disconnected instead, ending its subscription so the
+// client can resume from the last version it received.
+type Client struct {
+	mu                  sync.RWMutex
+	subscribers         map[string]map[*subscriber]struct{}
+	crossingSubscribers map[string]map[*crossingSubscriber]struct{}
+}
+
+func NewMemory() *Client {
+	return &Client{
+		subscribers:         map[string]map[*subscriber]struct{}{},
+		crossingSubscribers: map[string]map[*crossingSubscriber]struct{}{},
+	}
+}
+
+func (c *Client) Publish(ctx context.Context, journeyID string, journey *model.Journey) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for s := range c.subscribers[journeyID] {
+		select {
+		case s.ch <- journey:
+		default:
+			log.Warn().Str("journeyId", journeyID).Msg("disconnecting slow journey subscriber")
+			c.unsubscribe(journeyID, s)
+		}
+	}
+
+	return nil
+}
+
+// unsubscribe removes the subscriber and closes its channel. The caller must hold the lock.
+func (c *Client) unsubscribe(journeyID string, s *subscriber) {
+	delete(c.subscribers[journeyID], s)
+	if len(c.subscribers[journeyID]) == 0 {
+		delete(c.subscribers, journeyID)
+	}
+	s.close()
+}
+
+func (c *Client) Subscribe(ctx context.Context, journeyID string) (<-chan *model.Journey, error) {
+	s := &subscriber{ch: make(chan *model.Journey, subscriberBuffer)}
+
+	c.mu.Lock()
+	if c.subscribers[journeyID] == nil {
+		c.subscribers[journeyID] = map[*subscriber]struct{}{}
+	}
+	c.subscribers[journeyID][s] = struct{}{}
+	c.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.unsubscribe(journeyID, s)
+	}()
+
+	return s.ch, nil
+}
+
+func (c *Client) PublishGeofenceCrossing(ctx context.Context, userID string, crossing *model.GeofenceCrossing) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for s := range c.crossingSubscribers[userID] {
+		select {
+		case s.ch <- crossing:
+		default:
+			log.Warn().Str("userId", userID).Msg("disconnecting slow geofence subscriber")
+			c.unsubscribeCrossings(userID, s)
+		}
+	}
+
+	return nil
+}
+
+// unsubscribeCrossings removes the subscriber and closes its channel. The caller must hold the lock.
+func (c *Client) unsubscribeCrossings(userID string, s *crossingSubscriber) {
+	delete(c.crossingSubscribers[userID], s)
+	if len(c.crossingSubscribers[userID]) == 0 {
+		delete(c.crossingSubscribers, userID)
+	}
+	s.close()
+}
+
+func (c *Client) SubscribeGeofenceCrossings(ctx context.Context, userID string) (<-chan *model.GeofenceCrossing, error) {
+	s := &crossingSubscriber{ch: make(chan *model.GeofenceCrossing, subscriberBuffer)}
+
+	c.mu.Lock()
+	if c.crossingSubscribers[userID] == nil {
+		c.crossingSubscribers[userID] = map[*crossingSubscriber]struct{}{}
+	}
+	c.crossingSubscribers[userID][s] = struct{}{}
+	c.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.unsubscribeCrossings(userID, s)
+	}()
+
+	return s.ch, nil
+}
+
+// Subscriptions returns the ids of journeys with at least one subscriber.
+func (c *Client) Subscriptions() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ids := make([]string, 0, len(c.subscribers))
+	for id := range c.subscribers {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
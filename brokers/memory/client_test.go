@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"context"
+	"github.com/cobbinma/track-api/graph/model"
+	"testing"
+	"time"
+)
+
+// receive returns the next update on the channel, failing the test if none arrives in time.
+func receive(t *testing.T, ch <-chan *model.Journey) *model.Journey {
+	t.Helper()
+
+	select {
+	case j, ok := <-ch:
+		if !ok {
+			t.Fatal("expected an update, channel was closed")
+		}
+		return j
+	case <-time.After(time.Second):
+		t.Fatal("expected an update, got none")
+		return nil
+	}
+}
+
+func TestPublish(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker := NewMemory()
+	first, err := broker.Subscribe(ctx, "journey")
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	second, err := broker.Subscribe(ctx, "journey")
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	other, err := broker.Subscribe(ctx, "other")
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	if err := broker.Publish(ctx, "journey", &model.Journey{ID: "journey"}); err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+
+	for _, ch := range []<-chan *model.Journey{first, second} {
+		if j := receive(t, ch); j.ID != "journey" {
+			t.Errorf("expected journey, got %s", j.ID)
+		}
+	}
+	select {
+	case j := <-other:
+		t.Errorf("expected no update for another journey, got %s", j.ID)
+	default:
+	}
+}
+
+func TestSubscribeClosesWhenDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	broker := NewMemory()
+	ch, err := broker.Subscribe(ctx, "journey")
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Fatal("expected channel to be closed, got an update")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected channel to be closed once the subscription is done")
+	}
+
+	if err := broker.Publish(context.Background(), "journey", &model.Journey{ID: "journey"}); err != nil {
+		t.Errorf("expected publishing without subscribers to succeed, got %v", err)
+	}
+}
+
+func TestPublishDisconnectsSlowSubscriber(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker := NewMemory()
+	slow, err := broker.Subscribe(ctx, "journey")
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	fast, err := broker.Subscribe(ctx, "journey")
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	// publishing must not wait for the slow subscriber once its buffer is full
+	for version := 1; version <= subscriberBuffer+1; version++ {
+		if err := broker.Publish(ctx, "journey", &model.Journey{ID: "journey", Version: version}); err != nil {
+			t.Fatalf("unable to publish: %v", err)
+		}
+		if j := receive(t, fast); j.Version != version {
+			t.Errorf("expected version %d, got %d", version, j.Version)
+		}
+	}
+
+	for version := 1; version <= subscriberBuffer; version++ {
+		if j := receive(t, slow); j.Version != version {
+			t.Errorf("expected the slow subscriber to keep version %d, got %d", version, j.Version)
+		}
+	}
+	if _, ok := <-slow; ok {
+		t.Error("expected the slow subscriber to be disconnected once it fell behind")
+	}
+}
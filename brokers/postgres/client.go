@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cobbinma/track-api/brokers"
+	"github.com/cobbinma/track-api/brokers/memory"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/repositories/postgres"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+	"net/url"
+	"time"
+)
+
+const (
+	channel           = "journey_updates"
+	referencesChannel = "journey_references"
+	crossingsChannel  = "geofence_crossings"
+	// maxPayload is the largest notification payload postgres accepts, in bytes.
+	maxPayload           = 7999
+	minReconnectInterval = time.Second
+	maxReconnectInterval = time.Minute
+	pingInterval         = 90 * time.Second
+)
+
+var _ brokers.Broker = (*Client)(nil)
+
+// Client publishes journey updates with postgres NOTIFY so they are sent only when the surrounding repository
+// transaction commits, and delivers them to subscribers in this process from a LISTEN connection.
+type Client struct {
+	listener   *pq.Listener
+	repository *postgres.Client
+	local      *memory.Client
+}
+
+func NewPostgres(url url.URL, repository *postgres.Client) (*Client, error) {
+	listener := pq.NewListener(url.String(), minReconnectInterval, maxReconnectInterval,
+		func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				log.Warn().Err(err).Int("event", int(event)).Msg("postgres listener event")
+			}
+		})
+
+	for _, ch := range []string{channel, referencesChannel, crossingsChannel} {
+		if err := listener.Listen(ch); err != nil {
+			_ = listener.Close()
+			return nil, fmt.Errorf("listen : %w", err)
+		}
+	}
+
+	c := &Client{
+		listener:   listener,
+		repository: repository,
+		local:      memory.NewMemory(),
+	}
+	go c.run()
+
+	return c, nil
+}
+
+// reference identifies a journey update too large to notify, listeners read the journey from the repository instead.
+type reference struct {
+	ID      string              `json:"id"`
+	Version int                 `json:"version"`
+	Event   *model.JourneyEvent `json:"event,omitempty"`
+}
+
+// notification returns the channel and payload that publish the journey, referring to the journey rather than
+// sending it whole when it is too large to notify.
+func notification(journey *model.Journey) (string, string, error) {
+	payload, err := json.Marshal(journey)
+	if err != nil {
+		return "", "", fmt.Errorf("marshal : %w", err)
+	}
+	if len(payload) <= maxPayload {
+		return channel, string(payload), nil
+	}
+
+	payload, err = json.Marshal(reference{ID: journey.ID, Version: journey.Version, Event: journey.Event})
+	if err != nil {
+		return "", "", fmt.Errorf("marshal : %w", err)
+	}
+
+	return referencesChannel, string(payload), nil
+}
+
+func (c *Client) Publish(ctx context.Context, journeyID string, journey *model.Journey) error {
+	ch, payload, err := notification(journey)
+	if err != nil {
+		return err
+	}
+
+	if err := c.repository.Notify(ctx, ch, payload); err != nil {
+		return fmt.Errorf("notify : %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) Subscribe(ctx context.Context, journeyID string) (<-chan *model.Journey, error) {
+	return c.local.Subscribe(ctx, journeyID)
+}
+
+func (c *Client) PublishGeofenceCrossing(ctx context.Context, userID string, crossing *model.GeofenceCrossing) error {
+	payload, err := json.Marshal(crossing)
+	if err != nil {
+		return fmt.Errorf("marshal : %w", err)
+	}
+
+	if err := c.repository.Notify(ctx, crossingsChannel, string(payload)); err != nil {
+		return fmt.Errorf("notify : %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) SubscribeGeofenceCrossings(ctx context.Context, userID string) (<-chan *model.GeofenceCrossing, error) {
+	return c.local.SubscribeGeofenceCrossings(ctx, userID)
+}
+
+// Close stops listening for notifications.
+func (c *Client) Close() error {
+	return c.listener.Close()
+}
+
+func (c *Client) run() {
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case n, ok := <-c.listener.Notify:
+			if !ok {
+				return
+			}
+
+			// the listener sends nil after reconnecting, any notifications sent while disconnected were lost
+			if n == nil {
+				c.backfill()
+				continue
+			}
+
+			switch n.Channel {
+			case crossingsChannel:
+				c.deliverCrossing(n.Extra)
+				continue
+			case referencesChannel:
+				c.deliverReference(n.Extra)
+				continue
+			}
+
+			var journey = &model.Journey{}
+			if err := json.Unmarshal([]byte(n.Extra), journey); err != nil {
+				log.Error().Err(err).Msg("unable to unmarshal notification")
+				continue
+			}
+
+			if err := c.local.Publish(context.Background(), journey.ID, journey); err != nil {
+				log.Error().Err(err).Str("journeyId", journey.ID).Msg("unable to publish journey to subscribers")
+			}
+		case <-ticker.C:
+			if err := c.listener.Ping(); err != nil {
+				log.Warn().Err(err).Msg("unable to ping postgres listener")
+			}
+		}
+	}
+}
+
+// deliverCrossing sends the crossing to subscribers in this process. Crossings are not backfilled after a dropped
+// connection, they describe a moment that has passed rather than state a subscriber can catch up on.
+func (c *Client) deliverCrossing(payload string) {
+	var crossing = &model.GeofenceCrossing{}
+	if err := json.Unmarshal([]byte(payload), crossing); err != nil {
+		log.Error().Err(err).Msg("unable to unmarshal notification")
+		return
+	}
+
+	if err := c.local.PublishGeofenceCrossing(context.Background(), crossing.UserID, crossing); err != nil {
+		log.Error().Err(err).Str("userId", crossing.UserID).Msg("unable to publish geofence crossing to subscribers")
+	}
+}
+
+// deliverReference reads the referenced journey, which has committed by the time the notification arrives, and sends
+// it to subscribers in this process.
+func (c *Client) deliverReference(payload string) {
+	var ref reference
+	if err := json.Unmarshal([]byte(payload), &ref); err != nil {
+		log.Error().Err(err).Msg("unable to unmarshal notification")
+		return
+	}
+
+	ctx := context.Background()
+	journey, err := c.repository.GetJourney(ctx, ref.ID)
+	if err != nil {
+		log.Error().Err(err).Str("journeyId", ref.ID).Msg("unable to get journey from repository")
+		return
+	}
+	// the event only describes the referenced version, a later one will be delivered with its own
+	if journey.Version == ref.Version {
+		journey.Event = ref.Event
+	}
+
+	if err := c.local.Publish(ctx, ref.ID, journey); err != nil {
+		log.Error().Err(err).Str("journeyId", ref.ID).Msg("unable to publish journey to subscribers")
+	}
+}
+
+// backfill sends subscribers the latest state of their journeys from the repository.
+func (c *Client) backfill() {
+	ctx := context.Background()
+	for _, id := range c.local.Subscriptions() {
+		journey, err := c.repository.GetJourney(ctx, id)
+		if err != nil {
+			log.Error().Err(err).Str("journeyId", id).Msg("unable to get journey from repository")
+			continue
+		}
+
+		if err := c.local.Publish(ctx, id, journey); err != nil {
+			log.Error().Err(err).Str("journeyId", id).Msg("unable to publish journey to subscribers")
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/repositories/postgres"
+	"github.com/google/uuid"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestClient publishes through the database in TEST_DATABASE_URL, migrating it up first. It is skipped when none is
+// given.
+func TestClient(t *testing.T) {
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL not given")
+	}
+
+	dbu, err := url.Parse(databaseURL)
+	if err != nil {
+		t.Fatalf("unable to parse TEST_DATABASE_URL: %v", err)
+	}
+
+	repository, err := postgres.NewPostgres(*dbu, "file://../../repositories/postgres/migrations")
+	if err != nil {
+		t.Fatalf("unable to connect to postgres: %v", err)
+	}
+
+	broker, err := NewPostgres(*dbu, repository)
+	if err != nil {
+		t.Fatalf("unable to listen to postgres: %v", err)
+	}
+	defer broker.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	journeyID := uuid.New().String()
+	ch, err := broker.Subscribe(ctx, journeyID)
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	errRollback := errors.New("rollback")
+	if err := repository.Transaction(ctx, func(ctx context.Context) error {
+		journey := &model.Journey{ID: journeyID, Status: model.JourneyStatusActive}
+		if err := broker.Publish(ctx, journeyID, journey); err != nil {
+			return err
+		}
+		return errRollback
+	}); !errors.Is(err, errRollback) {
+		t.Fatalf("expected the transaction to be rolled back, got %v", err)
+	}
+
+	if err := repository.Transaction(ctx, func(ctx context.Context) error {
+		return broker.Publish(ctx, journeyID, &model.Journey{ID: journeyID, Status: model.JourneyStatusComplete})
+	}); err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+
+	select {
+	case j := <-ch:
+		if j.Status != model.JourneyStatusComplete {
+			t.Errorf("expected only the committed update, got status %s", j.Status)
+		}
+	case <-ctx.Done():
+		t.Fatal("expected the committed update, got none")
+	}
+
+	// an update too large to notify is read back from the repository
+	now := time.Now().UTC()
+	journey := &model.Journey{ID: journeyID, User: &model.User{ID: uuid.New().String()},
+		Status: model.JourneyStatusActive, Version: 1, CreatedAt: now, UpdatedAt: now}
+	if err := repository.CreateJourney(ctx, journey); err != nil {
+		t.Fatalf("unable to create journey: %v", err)
+	}
+	large := *journey
+	large.User = &model.User{ID: strings.Repeat("x", maxPayload)}
+	if err := broker.Publish(ctx, journeyID, &large); err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+
+	select {
+	case j := <-ch:
+		if j.User == nil || j.User.ID != journey.User.ID || j.Version != journey.Version {
+			t.Errorf("expected the journey read from the repository, got %+v", j)
+		}
+	case <-ctx.Done():
+		t.Fatal("expected the large update, got none")
+	}
+}
+
+func TestNotification(t *testing.T) {
+	event := model.JourneyEventArrived
+	journey := &model.Journey{ID: uuid.New().String(), Version: 3, Event: &event}
+
+	ch, payload, err := notification(journey)
+	if err != nil {
+		t.Fatalf("unable to build notification: %v", err)
+	}
+	if ch != channel || !strings.Contains(payload, journey.ID) {
+		t.Errorf("expected a small journey to be sent whole on %s, got %s on %s", channel, payload, ch)
+	}
+
+	journey.User = &model.User{ID: strings.Repeat("x", maxPayload)}
+	ch, payload, err = notification(journey)
+	if err != nil {
+		t.Fatalf("unable to build notification: %v", err)
+	}
+	if ch != referencesChannel || len(payload) > maxPayload {
+		t.Fatalf("expected a large journey to be referred to on %s, got %d bytes on %s", referencesChannel,
+			len(payload), ch)
+	}
+
+	var ref reference
+	if err := json.Unmarshal([]byte(payload), &ref); err != nil {
+		t.Fatalf("unable to unmarshal reference: %v", err)
+	}
+	if ref.ID != journey.ID || ref.Version != 3 || ref.Event == nil || *ref.Event != event {
+		t.Errorf("expected a reference to version 3 of the journey with its event, got %+v", ref)
+	}
+}
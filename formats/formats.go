@@ -0,0 +1,216 @@
+package formats
+
+import (
+	"errors"
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"io"
+	"math"
+	"mime"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// flushInterval is the number of points written between flushes, so long tracks are streamed to the client rather
+// than buffered.
+const flushInterval = 100
+
+// Format is a file format journeys can be exported or imported as.
+type Format string
+
+const (
+	GPX     Format = "gpx"
+	GeoJSON Format = "geojson"
+	KML     Format = "kml"
+	TCX     Format = "tcx"
+)
+
+// maxImportPoints bounds the size of an imported track.
+const maxImportPoints = 100000
+
+var (
+	ErrNoPoints           = errors.New("no track points")
+	ErrTooManyPoints      = fmt.Errorf("more than %d track points", maxImportPoints)
+	ErrInvalidCoordinates = errors.New("invalid coordinates")
+	ErrMixedTimes         = errors.New("some track points have a time and others do not")
+)
+
+var contentTypes = map[Format]string{
+	GPX:     "application/gpx+xml",
+	GeoJSON: "application/geo+json",
+	KML:     "application/vnd.google-earth.kml+xml",
+}
+
+// ParseFormat returns the format journeys can be exported as.
+func ParseFormat(s string) (Format, error) {
+	f := Format(strings.ToLower(s))
+	if _, ok := contentTypes[f]; !ok {
+		return "", fmt.Errorf("unsupported format: %s", s)
+	}
+
+	return f, nil
+}
+
+// ParseImportFormat returns the format journeys can be imported from.
+func ParseImportFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case GPX, GeoJSON, TCX:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unsupported import format: %s", s)
+	}
+}
+
+// FormatFromFilename returns the import format matching the file's extension.
+func FormatFromFilename(filename string) (Format, error) {
+	ext := strings.TrimPrefix(strings.ToLower(path.Ext(filename)), ".")
+	if ext == "json" {
+		ext = string(GeoJSON)
+	}
+
+	return ParseImportFormat(ext)
+}
+
+// Negotiate returns the first format in the accept header that is supported, or false if there are none.
+func Negotiate(accept string) (Format, bool) {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		for f, contentType := range contentTypes {
+			if mediaType == contentType {
+				return f, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func (f Format) ContentType() string {
+	return contentTypes[f]
+}
+
+func (f Format) Extension() string {
+	return string(f)
+}
+
+// NewEncoder returns an encoder writing the format to w.
+func (f Format) NewEncoder(w io.Writer) (Encoder, error) {
+	switch f {
+	case GPX:
+		return NewGPXEncoder(w), nil
+	case GeoJSON:
+		return NewGeoJSONEncoder(w), nil
+	case KML:
+		return NewKMLEncoder(w), nil
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", f)
+	}
+}
+
+// Decode reads the track points of the file, sorted by the time they were recorded. A track without times is kept in
+// file order and given the time it was decoded, a track with times on only some of its points is rejected.
+func (f Format) Decode(r io.Reader) ([]*model.TrackPoint, error) {
+	var (
+		points []*model.TrackPoint
+		err    error
+	)
+	switch f {
+	case GPX:
+		points, err = decodeGPX(r)
+	case GeoJSON:
+		points, err = decodeGeoJSON(r)
+	case TCX:
+		points, err = decodeTCX(r)
+	default:
+		return nil, fmt.Errorf("unsupported import format: %s", f)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(points); err != nil {
+		return nil, err
+	}
+
+	if err := order(points); err != nil {
+		return nil, err
+	}
+
+	for i, p := range points {
+		p.Seq = i + 1
+	}
+
+	return points, nil
+}
+
+// point returns a track point from a decoded position, parsing its time if it has one. The time of a point without
+// one is left zero.
+func point(lat, lng float64, recordedAt string) (*model.TrackPoint, error) {
+	p := &model.TrackPoint{Lat: lat, Lng: lng}
+	if recordedAt = strings.TrimSpace(recordedAt); recordedAt != "" {
+		t, err := time.Parse(time.RFC3339, recordedAt)
+		if err != nil {
+			return nil, fmt.Errorf("parse time : %w", err)
+		}
+		p.RecordedAt = t.UTC()
+	}
+
+	return p, nil
+}
+
+func validate(points []*model.TrackPoint) error {
+	if len(points) == 0 {
+		return ErrNoPoints
+	}
+
+	if len(points) > maxImportPoints {
+		return ErrTooManyPoints
+	}
+
+	for _, p := range points {
+		if math.IsNaN(p.Lat) || math.IsNaN(p.Lng) || p.Lat < -90 || p.Lat > 90 || p.Lng < -180 || p.Lng > 180 {
+			return fmt.Errorf("%w: %v, %v", ErrInvalidCoordinates, p.Lat, p.Lng)
+		}
+	}
+
+	return nil
+}
+
+// order sorts the points by the time they were recorded, or gives them all the current time if none were.
+func order(points []*model.TrackPoint) error {
+	var timed int
+	for _, p := range points {
+		if !p.RecordedAt.IsZero() {
+			timed++
+		}
+	}
+
+	switch timed {
+	case 0:
+		now := time.Now().UTC()
+		for _, p := range points {
+			p.RecordedAt = now
+		}
+	case len(points):
+		sort.SliceStable(points, func(i, j int) bool { return points[i].RecordedAt.Before(points[j].RecordedAt) })
+	default:
+		return fmt.Errorf("%w: %d of %d", ErrMixedTimes, timed, len(points))
+	}
+
+	return nil
+}
+
+// Encoder writes a journey and its track point by point.
+type Encoder interface {
+	// Begin writes everything preceding the first point.
+	Begin(journey *model.Journey) error
+	Point(point *model.TrackPoint) error
+	// End writes everything following the last point and flushes the output.
+	End() error
+}
@@ -0,0 +1,262 @@
+package formats
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type expectedPoint struct {
+	lat, lng float64
+	// recordedAt is empty for points decoded without a time
+	recordedAt string
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   Format
+		input    string
+		expected []expectedPoint
+		err      error
+		invalid  bool
+	}{
+		{
+			name:   "gpx track",
+			format: GPX,
+			input: `<?xml version="1.0"?>
+<gpx version="1.1" xmlns="http://www.topografix.com/GPX/1/1">
+  <trk><trkseg>
+    <trkpt lat="51.5" lon="-0.12"><time>2021-06-01T09:00:00Z</time></trkpt>
+    <trkpt lat="51.501" lon="-0.121"><time>2021-06-01T10:00:05+01:00</time></trkpt>
+  </trkseg><trkseg>
+    <trkpt lat="51.502" lon="-0.122"><time>2021-06-01T09:00:10Z</time></trkpt>
+  </trkseg></trk>
+</gpx>`,
+			expected: []expectedPoint{
+				{51.5, -0.12, "2021-06-01T09:00:00Z"},
+				{51.501, -0.121, "2021-06-01T09:00:05Z"},
+				{51.502, -0.122, "2021-06-01T09:00:10Z"},
+			},
+		},
+		{
+			name:   "gpx segments out of order",
+			format: GPX,
+			input: `<gpx><trk>
+  <trkseg><trkpt lat="3" lon="4"><time>2021-06-01T10:00:00Z</time></trkpt></trkseg>
+  <trkseg>
+    <trkpt lat="1" lon="2"><time>2021-06-01T09:00:00Z</time></trkpt>
+    <trkpt lat="2" lon="3"><time>2021-06-01T09:00:00Z</time></trkpt>
+  </trkseg>
+</trk></gpx>`,
+			expected: []expectedPoint{
+				{1, 2, "2021-06-01T09:00:00Z"},
+				{2, 3, "2021-06-01T09:00:00Z"},
+				{3, 4, "2021-06-01T10:00:00Z"},
+			},
+		},
+		{
+			name:   "gpx with some times missing",
+			format: GPX,
+			input: `<gpx><trk><trkseg>
+  <trkpt lat="1" lon="2"><time>2021-06-01T09:00:00Z</time></trkpt>
+  <trkpt lat="3" lon="4"/>
+</trkseg></trk></gpx>`,
+			err: ErrMixedTimes,
+		},
+		{
+			name:   "gpx route",
+			format: GPX,
+			input: `<gpx><rte>
+  <rtept lat="1" lon="2"/>
+  <rtept lat="3" lon="4"/>
+</rte></gpx>`,
+			expected: []expectedPoint{{1, 2, ""}, {3, 4, ""}},
+		},
+		{
+			name:   "gpx without points",
+			format: GPX,
+			input:  `<gpx><trk><trkseg></trkseg></trk></gpx>`,
+			err:    ErrNoPoints,
+		},
+		{
+			name:   "gpx out of bounds",
+			format: GPX,
+			input:  `<gpx><trk><trkseg><trkpt lat="91" lon="0"/></trkseg></trk></gpx>`,
+			err:    ErrInvalidCoordinates,
+		},
+		{
+			name:    "gpx invalid time",
+			format:  GPX,
+			input:   `<gpx><trk><trkseg><trkpt lat="1" lon="2"><time>yesterday</time></trkpt></trkseg></trk></gpx>`,
+			invalid: true,
+		},
+		{
+			name:    "malformed gpx",
+			format:  GPX,
+			input:   `<gpx><trk>`,
+			invalid: true,
+		},
+		{
+			name:   "tcx",
+			format: TCX,
+			input: `<TrainingCenterDatabase><Activities><Activity><Lap><Track>
+  <Trackpoint>
+    <Time>2021-06-01T09:00:00Z</Time>
+    <Position><LatitudeDegrees>51.5</LatitudeDegrees><LongitudeDegrees>-0.12</LongitudeDegrees></Position>
+  </Trackpoint>
+  <Trackpoint><Time>2021-06-01T09:00:01Z</Time></Trackpoint>
+  <Trackpoint>
+    <Time>2021-06-01T09:00:02Z</Time>
+    <Position><LatitudeDegrees>51.501</LatitudeDegrees><LongitudeDegrees>-0.121</LongitudeDegrees></Position>
+  </Trackpoint>
+</Track></Lap></Activity></Activities></TrainingCenterDatabase>`,
+			expected: []expectedPoint{
+				{51.5, -0.12, "2021-06-01T09:00:00Z"},
+				{51.501, -0.121, "2021-06-01T09:00:02Z"},
+			},
+		},
+		{
+			name:   "tcx without positions",
+			format: TCX,
+			input: `<TrainingCenterDatabase><Activities><Activity><Lap><Track>
+  <Trackpoint><Time>2021-06-01T09:00:01Z</Time></Trackpoint>
+</Track></Lap></Activity></Activities></TrainingCenterDatabase>`,
+			err: ErrNoPoints,
+		},
+		{
+			name:   "geojson line string",
+			format: GeoJSON,
+			input: `{"type": "Feature", "properties": {"coordTimes": ["2021-06-01T09:00:00Z", "2021-06-01T09:00:05Z"]},
+"geometry": {"type": "LineString", "coordinates": [[-0.12, 51.5], [-0.121, 51.501, 12.5]]}}`,
+			expected: []expectedPoint{
+				{51.5, -0.12, "2021-06-01T09:00:00Z"},
+				{51.501, -0.121, "2021-06-01T09:00:05Z"},
+			},
+		},
+		{
+			name:   "geojson with fewer times than coordinates",
+			format: GeoJSON,
+			input: `{"type": "Feature", "properties": {"coordTimes": ["2021-06-01T09:00:00Z"]},
+"geometry": {"type": "LineString", "coordinates": [[-0.12, 51.5], [-0.121, 51.501]]}}`,
+			err: ErrMixedTimes,
+		},
+		{
+			name:   "geojson feature collection",
+			format: GeoJSON,
+			input: `{"type": "FeatureCollection", "features": [
+  {"type": "Feature", "properties": {}, "geometry": {"type": "Point", "coordinates": [0, 0]}},
+  {"type": "Feature", "properties": {}, "geometry": {"type": "MultiLineString", "coordinates": [[[2, 1]], [[4, 3]]]}},
+  {"type": "Feature", "properties": {}, "geometry": null}
+]}`,
+			expected: []expectedPoint{{1, 2, ""}, {3, 4, ""}},
+		},
+		{
+			name:   "geojson bare geometry",
+			format: GeoJSON,
+			input:  `{"type": "LineString", "coordinates": [[2, 1]]}`,
+			expected: []expectedPoint{
+				{1, 2, ""},
+			},
+		},
+		{
+			name:   "geojson missing latitude",
+			format: GeoJSON,
+			input:  `{"type": "LineString", "coordinates": [[2]]}`,
+			err:    ErrInvalidCoordinates,
+		},
+		{
+			name:   "geojson without lines",
+			format: GeoJSON,
+			input:  `{"type": "Point", "coordinates": [2, 1]}`,
+			err:    ErrNoPoints,
+		},
+		{
+			name:    "malformed geojson",
+			format:  GeoJSON,
+			input:   `{"type": `,
+			invalid: true,
+		},
+		{
+			name:    "unsupported format",
+			format:  KML,
+			input:   `<kml/>`,
+			invalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points, err := tt.format.Decode(strings.NewReader(tt.input))
+			if tt.err != nil || tt.invalid {
+				if err == nil {
+					t.Fatalf("expected an error, got %d points", len(points))
+				}
+				if tt.err != nil && !errors.Is(err, tt.err) {
+					t.Errorf("expected %v, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(points) != len(tt.expected) {
+				t.Fatalf("expected %d points, got %d", len(tt.expected), len(points))
+			}
+			for i, p := range points {
+				e := tt.expected[i]
+				if p.Seq != i+1 {
+					t.Errorf("point %d: expected seq %d, got %d", i, i+1, p.Seq)
+				}
+				if p.Lat != e.lat || p.Lng != e.lng {
+					t.Errorf("point %d: expected %v, %v, got %v, %v", i, e.lat, e.lng, p.Lat, p.Lng)
+				}
+				if e.recordedAt == "" {
+					if p.RecordedAt.IsZero() {
+						t.Errorf("point %d: expected the time it was decoded, got none", i)
+					}
+					continue
+				}
+				if recordedAt, _ := time.Parse(time.RFC3339, e.recordedAt); !p.RecordedAt.Equal(recordedAt) {
+					t.Errorf("point %d: expected recorded at %s, got %s", i, e.recordedAt, p.RecordedAt)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatFromFilename(t *testing.T) {
+	tests := []struct {
+		filename string
+		expected Format
+		invalid  bool
+	}{
+		{filename: "morning.gpx", expected: GPX},
+		{filename: "MORNING.GPX", expected: GPX},
+		{filename: "run.tcx", expected: TCX},
+		{filename: "walk.geojson", expected: GeoJSON},
+		{filename: "walk.json", expected: GeoJSON},
+		{filename: "walk.kml", invalid: true},
+		{filename: "walk", invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			got, err := FormatFromFilename(tt.filename)
+			if tt.invalid {
+				if err == nil {
+					t.Errorf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package formats
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"io"
+	"strconv"
+	"time"
+)
+
+var _ Encoder = (*GeoJSONEncoder)(nil)
+
+// GeoJSONEncoder writes a journey as a GeoJSON feature collection holding a LineString of the track, followed by
+// Point features for its start and end.
+type GeoJSONEncoder struct {
+	w       *bufio.Writer
+	journey *model.Journey
+	first   *model.TrackPoint
+	last    *model.TrackPoint
+	points  int
+}
+
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   geoJSONGeometry        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type geoJSONGeometry struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+func NewGeoJSONEncoder(w io.Writer) *GeoJSONEncoder {
+	return &GeoJSONEncoder{w: bufio.NewWriter(w)}
+}
+
+func (e *GeoJSONEncoder) Begin(journey *model.Journey) error {
+	e.journey = journey
+	if _, err := e.w.WriteString(`{"type":"FeatureCollection","features":[` +
+		`{"type":"Feature","geometry":{"type":"LineString","coordinates":[`); err != nil {
+		return fmt.Errorf("write : %w", err)
+	}
+
+	return nil
+}
+
+func (e *GeoJSONEncoder) Point(point *model.TrackPoint) error {
+	if e.points > 0 {
+		if err := e.w.WriteByte(','); err != nil {
+			return fmt.Errorf("write : %w", err)
+		}
+	}
+
+	if _, err := e.w.WriteString("[" + strconv.FormatFloat(point.Lng, 'f', -1, 64) + "," +
+		strconv.FormatFloat(point.Lat, 'f', -1, 64) + "]"); err != nil {
+		return fmt.Errorf("write : %w", err)
+	}
+
+	p := *point
+	if e.first == nil {
+		e.first = &p
+	}
+	e.last = &p
+
+	e.points++
+	if e.points%flushInterval == 0 {
+		if err := e.w.Flush(); err != nil {
+			return fmt.Errorf("flush : %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (e *GeoJSONEncoder) End() error {
+	properties := map[string]interface{}{
+		"journeyId": e.journey.ID,
+		"status":    e.journey.Status,
+	}
+	if e.first != nil {
+		properties["startedAt"] = e.first.RecordedAt.UTC().Format(time.RFC3339)
+		properties["endedAt"] = e.last.RecordedAt.UTC().Format(time.RFC3339)
+	}
+
+	b, err := json.Marshal(properties)
+	if err != nil {
+		return fmt.Errorf("marshal : %w", err)
+	}
+
+	if _, err := e.w.WriteString(`]},"properties":` + string(b) + "}"); err != nil {
+		return fmt.Errorf("write : %w", err)
+	}
+
+	for _, p := range []struct {
+		kind  string
+		point *model.TrackPoint
+	}{{kind: "start", point: e.first}, {kind: "end", point: e.last}} {
+		if p.point == nil {
+			continue
+		}
+
+		b, err := json.Marshal(geoJSONFeature{
+			Type: "Feature",
+			Geometry: geoJSONGeometry{
+				Type:        "Point",
+				Coordinates: []float64{p.point.Lng, p.point.Lat},
+			},
+			Properties: map[string]interface{}{
+				"journeyId":  e.journey.ID,
+				"status":     e.journey.Status,
+				"kind":       p.kind,
+				"recordedAt": p.point.RecordedAt.UTC().Format(time.RFC3339),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("marshal : %w", err)
+		}
+
+		if _, err := e.w.WriteString("," + string(b)); err != nil {
+			return fmt.Errorf("write : %w", err)
+		}
+	}
+
+	if _, err := e.w.WriteString("]}"); err != nil {
+		return fmt.Errorf("write : %w", err)
+	}
+
+	if err := e.w.Flush(); err != nil {
+		return fmt.Errorf("flush : %w", err)
+	}
+
+	return nil
+}
+
+type geoJSONObject struct {
+	Type       string            `json:"type"`
+	Features   []json.RawMessage `json:"features"`
+	Geometry   json.RawMessage   `json:"geometry"`
+	Properties struct {
+		CoordTimes json.RawMessage `json:"coordTimes"`
+	} `json:"properties"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// decodeGeoJSON reads the points of every LineString and MultiLineString in the object. Times are read from the
+// coordTimes property of features where present.
+func decodeGeoJSON(r io.Reader) ([]*model.TrackPoint, error) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("decode : %w", err)
+	}
+
+	return geoJSONPoints(raw, nil)
+}
+
+func geoJSONPoints(raw json.RawMessage, coordTimes json.RawMessage) ([]*model.TrackPoint, error) {
+	var o geoJSONObject
+	if err := json.Unmarshal(raw, &o); err != nil {
+		return nil, fmt.Errorf("unmarshal : %w", err)
+	}
+
+	var lines [][][]float64
+	var times [][]string
+	switch o.Type {
+	case "FeatureCollection":
+		var points []*model.TrackPoint
+		for _, f := range o.Features {
+			ps, err := geoJSONPoints(f, nil)
+			if err != nil {
+				return nil, err
+			}
+			points = append(points, ps...)
+		}
+		return points, nil
+	case "Feature":
+		if len(o.Geometry) == 0 || string(o.Geometry) == "null" {
+			return nil, nil
+		}
+		return geoJSONPoints(o.Geometry, o.Properties.CoordTimes)
+	case "LineString":
+		var line [][]float64
+		if err := json.Unmarshal(o.Coordinates, &line); err != nil {
+			return nil, fmt.Errorf("unmarshal coordinates : %w", err)
+		}
+		lines = [][][]float64{line}
+
+		var ts []string
+		if len(coordTimes) > 0 {
+			if err := json.Unmarshal(coordTimes, &ts); err != nil {
+				return nil, fmt.Errorf("unmarshal coord times : %w", err)
+			}
+		}
+		times = [][]string{ts}
+	case "MultiLineString":
+		if err := json.Unmarshal(o.Coordinates, &lines); err != nil {
+			return nil, fmt.Errorf("unmarshal coordinates : %w", err)
+		}
+
+		if len(coordTimes) > 0 {
+			if err := json.Unmarshal(coordTimes, &times); err != nil {
+				return nil, fmt.Errorf("unmarshal coord times : %w", err)
+			}
+		}
+	default:
+		return nil, nil
+	}
+
+	var points []*model.TrackPoint
+	for i, line := range lines {
+		for j, c := range line {
+			if len(c) < 2 {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidCoordinates, c)
+			}
+
+			var recordedAt string
+			if i < len(times) && j < len(times[i]) {
+				recordedAt = times[i][j]
+			}
+
+			p, err := point(c[1], c[0], recordedAt)
+			if err != nil {
+				return nil, err
+			}
+			points = append(points, p)
+		}
+	}
+
+	return points, nil
+}
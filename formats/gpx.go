@@ -0,0 +1,149 @@
+package formats
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"io"
+	"strconv"
+	"time"
+)
+
+var _ Encoder = (*GPXEncoder)(nil)
+
+// GPXEncoder writes a journey as a GPX 1.1 document with a single track segment.
+type GPXEncoder struct {
+	w      io.Writer
+	enc    *xml.Encoder
+	points int
+}
+
+func NewGPXEncoder(w io.Writer) *GPXEncoder {
+	return &GPXEncoder{w: w, enc: xml.NewEncoder(w)}
+}
+
+func (e *GPXEncoder) Begin(journey *model.Journey) error {
+	if _, err := io.WriteString(e.w, xml.Header); err != nil {
+		return fmt.Errorf("write header : %w", err)
+	}
+
+	if err := e.enc.EncodeToken(xml.StartElement{
+		Name: xml.Name{Local: "gpx"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "version"}, Value: "1.1"},
+			{Name: xml.Name{Local: "creator"}, Value: "track-api"},
+			{Name: xml.Name{Local: "xmlns"}, Value: "http://www.topografix.com/GPX/1/1"},
+		},
+	}); err != nil {
+		return fmt.Errorf("encode token : %w", err)
+	}
+
+	if err := e.enc.EncodeElement(struct {
+		Name string `xml:"name"`
+		Time string `xml:"time"`
+	}{Name: journey.ID, Time: time.Now().UTC().Format(time.RFC3339)}, start("metadata")); err != nil {
+		return fmt.Errorf("encode element : %w", err)
+	}
+
+	for _, token := range []xml.Token{start("trk"), start("name"), xml.CharData(journey.ID), end("name"),
+		start("trkseg")} {
+		if err := e.enc.EncodeToken(token); err != nil {
+			return fmt.Errorf("encode token : %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (e *GPXEncoder) Point(point *model.TrackPoint) error {
+	if err := e.enc.EncodeElement(struct {
+		Lat  string `xml:"lat,attr"`
+		Lon  string `xml:"lon,attr"`
+		Time string `xml:"time"`
+	}{
+		Lat:  strconv.FormatFloat(point.Lat, 'f', -1, 64),
+		Lon:  strconv.FormatFloat(point.Lng, 'f', -1, 64),
+		Time: point.RecordedAt.UTC().Format(time.RFC3339),
+	}, start("trkpt")); err != nil {
+		return fmt.Errorf("encode element : %w", err)
+	}
+
+	e.points++
+	if e.points%flushInterval == 0 {
+		if err := e.enc.Flush(); err != nil {
+			return fmt.Errorf("flush : %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (e *GPXEncoder) End() error {
+	for _, token := range []xml.Token{end("trkseg"), end("trk"), end("gpx")} {
+		if err := e.enc.EncodeToken(token); err != nil {
+			return fmt.Errorf("encode token : %w", err)
+		}
+	}
+
+	if err := e.enc.Flush(); err != nil {
+		return fmt.Errorf("flush : %w", err)
+	}
+
+	return nil
+}
+
+func start(name string) xml.StartElement {
+	return xml.StartElement{Name: xml.Name{Local: name}}
+}
+
+func end(name string) xml.EndElement {
+	return xml.EndElement{Name: xml.Name{Local: name}}
+}
+
+type gpxPoint struct {
+	Lat  float64 `xml:"lat,attr"`
+	Lon  float64 `xml:"lon,attr"`
+	Time string  `xml:"time"`
+}
+
+type gpxFile struct {
+	Tracks []struct {
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+	Routes []struct {
+		Points []gpxPoint `xml:"rtept"`
+	} `xml:"rte"`
+}
+
+// decodeGPX reads the points of every track segment, or of every route if the file has no tracks.
+func decodeGPX(r io.Reader) ([]*model.TrackPoint, error) {
+	var f gpxFile
+	if err := xml.NewDecoder(r).Decode(&f); err != nil {
+		return nil, fmt.Errorf("decode : %w", err)
+	}
+
+	var gpxPoints []gpxPoint
+	for _, t := range f.Tracks {
+		for _, s := range t.Segments {
+			gpxPoints = append(gpxPoints, s.Points...)
+		}
+	}
+	if len(gpxPoints) == 0 {
+		for _, rte := range f.Routes {
+			gpxPoints = append(gpxPoints, rte.Points...)
+		}
+	}
+
+	points := make([]*model.TrackPoint, 0, len(gpxPoints))
+	for _, p := range gpxPoints {
+		point, err := point(p.Lat, p.Lon, p.Time)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+	}
+
+	return points, nil
+}
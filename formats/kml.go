@@ -0,0 +1,151 @@
+package formats
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"io"
+	"strconv"
+	"time"
+)
+
+var _ Encoder = (*KMLEncoder)(nil)
+
+// KMLEncoder writes a journey as a KML document holding a LineString placemark of the track, followed by Point
+// placemarks for its start and end.
+type KMLEncoder struct {
+	w       io.Writer
+	enc     *xml.Encoder
+	journey *model.Journey
+	first   *model.TrackPoint
+	last    *model.TrackPoint
+	points  int
+}
+
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+type kmlExtendedData struct {
+	Data []kmlData `xml:"Data"`
+}
+
+type kmlPoint struct {
+	Name         string          `xml:"name"`
+	When         string          `xml:"TimeStamp>when"`
+	ExtendedData kmlExtendedData `xml:"ExtendedData"`
+	Coordinates  string          `xml:"Point>coordinates"`
+}
+
+func NewKMLEncoder(w io.Writer) *KMLEncoder {
+	return &KMLEncoder{w: w, enc: xml.NewEncoder(w)}
+}
+
+func (e *KMLEncoder) Begin(journey *model.Journey) error {
+	e.journey = journey
+	if _, err := io.WriteString(e.w, xml.Header); err != nil {
+		return fmt.Errorf("write header : %w", err)
+	}
+
+	if err := e.enc.EncodeToken(xml.StartElement{
+		Name: xml.Name{Local: "kml"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: "http://www.opengis.net/kml/2.2"}},
+	}); err != nil {
+		return fmt.Errorf("encode token : %w", err)
+	}
+
+	for _, token := range []xml.Token{start("Document"), start("name"), xml.CharData(journey.ID), end("name"),
+		start("Placemark"), start("name"), xml.CharData(journey.ID), end("name")} {
+		if err := e.enc.EncodeToken(token); err != nil {
+			return fmt.Errorf("encode token : %w", err)
+		}
+	}
+
+	if err := e.enc.EncodeElement(e.extendedData(), start("ExtendedData")); err != nil {
+		return fmt.Errorf("encode element : %w", err)
+	}
+
+	for _, token := range []xml.Token{start("LineString"), start("coordinates")} {
+		if err := e.enc.EncodeToken(token); err != nil {
+			return fmt.Errorf("encode token : %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (e *KMLEncoder) Point(point *model.TrackPoint) error {
+	coordinates := kmlCoordinates(point)
+	if e.points > 0 {
+		coordinates = " " + coordinates
+	}
+
+	if err := e.enc.EncodeToken(xml.CharData(coordinates)); err != nil {
+		return fmt.Errorf("encode token : %w", err)
+	}
+
+	p := *point
+	if e.first == nil {
+		e.first = &p
+	}
+	e.last = &p
+
+	e.points++
+	if e.points%flushInterval == 0 {
+		if err := e.enc.Flush(); err != nil {
+			return fmt.Errorf("flush : %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (e *KMLEncoder) End() error {
+	for _, token := range []xml.Token{end("coordinates"), end("LineString"), end("Placemark")} {
+		if err := e.enc.EncodeToken(token); err != nil {
+			return fmt.Errorf("encode token : %w", err)
+		}
+	}
+
+	for _, p := range []struct {
+		kind  string
+		point *model.TrackPoint
+	}{{kind: "start", point: e.first}, {kind: "end", point: e.last}} {
+		if p.point == nil {
+			continue
+		}
+
+		if err := e.enc.EncodeElement(kmlPoint{
+			Name:         p.kind,
+			When:         p.point.RecordedAt.UTC().Format(time.RFC3339),
+			ExtendedData: e.extendedData(),
+			Coordinates:  kmlCoordinates(p.point),
+		}, start("Placemark")); err != nil {
+			return fmt.Errorf("encode element : %w", err)
+		}
+	}
+
+	for _, token := range []xml.Token{end("Document"), end("kml")} {
+		if err := e.enc.EncodeToken(token); err != nil {
+			return fmt.Errorf("encode token : %w", err)
+		}
+	}
+
+	if err := e.enc.Flush(); err != nil {
+		return fmt.Errorf("flush : %w", err)
+	}
+
+	return nil
+}
+
+func (e *KMLEncoder) extendedData() kmlExtendedData {
+	return kmlExtendedData{Data: []kmlData{
+		{Name: "journeyId", Value: e.journey.ID},
+		{Name: "status", Value: e.journey.Status.String()},
+	}}
+}
+
+func kmlCoordinates(point *model.TrackPoint) string {
+	return strconv.FormatFloat(point.Lng, 'f', -1, 64) + "," + strconv.FormatFloat(point.Lat, 'f', -1, 64)
+}
@@ -0,0 +1,53 @@
+package formats
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"io"
+)
+
+type tcxFile struct {
+	Activities []struct {
+		Laps []struct {
+			Tracks []struct {
+				Points []struct {
+					Time     string `xml:"Time"`
+					Position *struct {
+						Lat float64 `xml:"LatitudeDegrees"`
+						Lng float64 `xml:"LongitudeDegrees"`
+					} `xml:"Position"`
+				} `xml:"Trackpoint"`
+			} `xml:"Track"`
+		} `xml:"Lap"`
+	} `xml:"Activities>Activity"`
+}
+
+// decodeTCX reads the points of every activity lap, skipping those recorded without a position.
+func decodeTCX(r io.Reader) ([]*model.TrackPoint, error) {
+	var f tcxFile
+	if err := xml.NewDecoder(r).Decode(&f); err != nil {
+		return nil, fmt.Errorf("decode : %w", err)
+	}
+
+	var points []*model.TrackPoint
+	for _, a := range f.Activities {
+		for _, l := range a.Laps {
+			for _, t := range l.Tracks {
+				for _, p := range t.Points {
+					if p.Position == nil {
+						continue
+					}
+
+					point, err := point(p.Position.Lat, p.Position.Lng, p.Time)
+					if err != nil {
+						return nil, err
+					}
+					points = append(points, point)
+				}
+			}
+		}
+	}
+
+	return points, nil
+}
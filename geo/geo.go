@@ -0,0 +1,26 @@
+package geo
+
+import "math"
+
+// EarthRadius is the mean radius of the earth in metres.
+const EarthRadius = 6371008.8
+
+// Distance returns the great-circle distance in metres between two points given in degrees, using the haversine
+// formula.
+func Distance(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat, dLng := radians(lat2-lat1), radians(lng2-lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(radians(lat1))*math.Cos(radians(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// ValidCoordinates reports whether the latitude and longitude are within ±90 and ±180 degrees.
+func ValidCoordinates(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,166 @@
+package geo
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name                   string
+		lat1, lng1, lat2, lng2 float64
+		expected               float64
+		tolerance              float64
+	}{
+		{name: "same point", lat1: 51.5, lng1: -0.12, lat2: 51.5, lng2: -0.12, expected: 0, tolerance: 1e-9},
+		{name: "one degree of latitude", lat1: 0, lng1: 0, lat2: 1, lng2: 0, expected: 111195, tolerance: 1},
+		{name: "one degree of longitude at the equator", lat1: 0, lng1: 0, lat2: 0, lng2: 1, expected: 111195, tolerance: 1},
+		{name: "london to paris", lat1: 51.5074, lng1: -0.1278, lat2: 48.8566, lng2: 2.3522, expected: 343556, tolerance: 500},
+		{name: "antipodes", lat1: 0, lng1: 0, lat2: 0, lng2: 180, expected: math.Pi * EarthRadius, tolerance: 1},
+		{name: "across the antimeridian", lat1: 0, lng1: 179.5, lat2: 0, lng2: -179.5, expected: 111195, tolerance: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Distance(tt.lat1, tt.lng1, tt.lat2, tt.lng2)
+			if math.Abs(got-tt.expected) > tt.tolerance {
+				t.Errorf("expected %.1fm, got %.1fm", tt.expected, got)
+			}
+			if reverse := Distance(tt.lat2, tt.lng2, tt.lat1, tt.lng1); math.Abs(reverse-got) > 1e-6 {
+				t.Errorf("expected distance to be symmetric, got %.6f and %.6f", got, reverse)
+			}
+		})
+	}
+}
+
+func TestValidCoordinates(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lng float64
+		valid    bool
+	}{
+		{name: "origin", lat: 0, lng: 0, valid: true},
+		{name: "corners", lat: -90, lng: 180, valid: true},
+		{name: "latitude too large", lat: 90.1, lng: 0},
+		{name: "latitude too small", lat: -90.1, lng: 0},
+		{name: "longitude too large", lat: 0, lng: 180.1},
+		{name: "longitude too small", lat: 0, lng: -180.1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidCoordinates(tt.lat, tt.lng); got != tt.valid {
+				t.Errorf("expected %v, got %v", tt.valid, got)
+			}
+		})
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	tests := []struct {
+		name      string
+		points    []Point
+		tolerance float64
+		expected  []Point
+	}{
+		{
+			name:      "too few points",
+			points:    []Point{{0, 0}, {0, 1}},
+			tolerance: 10,
+			expected:  []Point{{0, 0}, {0, 1}},
+		},
+		{
+			name:      "no tolerance",
+			points:    []Point{{0, 0}, {0, 0.5}, {0, 1}},
+			tolerance: 0,
+			expected:  []Point{{0, 0}, {0, 0.5}, {0, 1}},
+		},
+		{
+			name:      "straight line",
+			points:    []Point{{0, 0}, {0, 0.0001}, {0, 0.0002}, {0, 0.0003}},
+			tolerance: 1,
+			expected:  []Point{{0, 0}, {0, 0.0003}},
+		},
+		{
+			name:      "deviation within tolerance",
+			points:    []Point{{0, 0}, {0.00005, 0.0005}, {0, 0.001}},
+			tolerance: 10,
+			expected:  []Point{{0, 0}, {0, 0.001}},
+		},
+		{
+			name:      "deviation over tolerance",
+			points:    []Point{{0, 0}, {0.001, 0.0005}, {0, 0.001}},
+			tolerance: 10,
+			expected:  []Point{{0, 0}, {0.001, 0.0005}, {0, 0.001}},
+		},
+		{
+			name:      "corner",
+			points:    []Point{{0, 0}, {0, 0.0005}, {0, 0.001}, {0.0005, 0.001}, {0.001, 0.001}},
+			tolerance: 1,
+			expected:  []Point{{0, 0}, {0, 0.001}, {0.001, 0.001}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Simplify(tt.points, tt.tolerance); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEncodePolyline(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   []Point
+		expected string
+	}{
+		{name: "no points", points: nil, expected: ""},
+		{name: "origin", points: []Point{{0, 0}}, expected: "??"},
+		{
+			// the example from Google's polyline algorithm documentation
+			name:     "documented example",
+			points:   []Point{{38.5, -120.2}, {40.7, -120.95}, {43.252, -126.453}},
+			expected: "_p~iF~ps|U_ulLnnqC_mqNvxq`@",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EncodePolyline(tt.points); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestInPolygon(t *testing.T) {
+	square := []Point{{0, 0}, {0, 1}, {1, 1}, {1, 0}}
+	// a U shape open to the north, whose notch is outside
+	u := []Point{{0, 0}, {0, 3}, {3, 3}, {3, 2}, {1, 2}, {1, 1}, {3, 1}, {3, 0}}
+
+	tests := []struct {
+		name    string
+		point   Point
+		polygon []Point
+		inside  bool
+	}{
+		{name: "centre of square", point: Point{0.5, 0.5}, polygon: square, inside: true},
+		{name: "north of square", point: Point{1.5, 0.5}, polygon: square},
+		{name: "west of square", point: Point{0.5, -0.5}, polygon: square},
+		{name: "arm of u", point: Point{2.5, 2.5}, polygon: u, inside: true},
+		{name: "base of u", point: Point{0.5, 1.5}, polygon: u, inside: true},
+		{name: "notch of u", point: Point{2, 1.5}, polygon: u},
+		{name: "too few vertices", point: Point{0, 0}, polygon: []Point{{0, 0}, {1, 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InPolygon(tt.point, tt.polygon); got != tt.inside {
+				t.Errorf("expected %v, got %v", tt.inside, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package geo
+
+// InPolygon reports whether the point is inside the polygon given by its vertices, using the even-odd rule. The
+// polygon is treated as planar, which holds for fences a few kilometres across away from the antimeridian.
+func InPolygon(p Point, polygon []Point) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
@@ -0,0 +1,36 @@
+package geo
+
+import (
+	"math"
+	"strings"
+)
+
+// polylinePrecision is the number of decimal places kept by an encoded polyline.
+const polylinePrecision = 1e5
+
+// EncodePolyline returns the points in Google's encoded polyline format.
+func EncodePolyline(points []Point) string {
+	var b strings.Builder
+	var lat, lng int64
+	for _, p := range points {
+		nextLat, nextLng := int64(math.Round(p.Lat*polylinePrecision)), int64(math.Round(p.Lng*polylinePrecision))
+		encodeValue(&b, nextLat-lat)
+		encodeValue(&b, nextLng-lng)
+		lat, lng = nextLat, nextLng
+	}
+
+	return b.String()
+}
+
+func encodeValue(b *strings.Builder, v int64) {
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+
+	for u >= 0x20 {
+		b.WriteByte(byte(0x20|(u&0x1f)) + 63)
+		u >>= 5
+	}
+	b.WriteByte(byte(u) + 63)
+}
@@ -0,0 +1,66 @@
+package geo
+
+import "math"
+
+// Point is a position in degrees.
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Simplify returns the points of the line with those closer than tolerance metres to the simplified line removed,
+// using the Douglas–Peucker algorithm. The first and last points are always kept.
+func Simplify(points []Point, tolerance float64) []Point {
+	if len(points) < 3 || tolerance <= 0 {
+		return points
+	}
+
+	keep := make([]bool, len(points))
+	keep[0], keep[len(points)-1] = true, true
+
+	type span struct{ first, last int }
+	stack := []span{{0, len(points) - 1}}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		furthest, distance := 0, 0.0
+		for i := s.first + 1; i < s.last; i++ {
+			if d := segmentDistance(points[i], points[s.first], points[s.last]); d > distance {
+				furthest, distance = i, d
+			}
+		}
+
+		if distance > tolerance {
+			keep[furthest] = true
+			stack = append(stack, span{s.first, furthest}, span{furthest, s.last})
+		}
+	}
+
+	simplified := make([]Point, 0, len(points))
+	for i, p := range points {
+		if keep[i] {
+			simplified = append(simplified, p)
+		}
+	}
+
+	return simplified
+}
+
+// segmentDistance returns the distance in metres from p to the segment between a and b, projecting the points onto a
+// plane around a, which is accurate enough over the length of a segment of a track.
+func segmentDistance(p, a, b Point) float64 {
+	scale := math.Cos(radians(a.Lat))
+	x := func(q Point) float64 { return radians(q.Lng-a.Lng) * scale * EarthRadius }
+	y := func(q Point) float64 { return radians(q.Lat-a.Lat) * EarthRadius }
+
+	px, py, bx, by := x(p), y(p), x(b), y(b)
+	length := bx*bx + by*by
+	if length == 0 {
+		return math.Hypot(px, py)
+	}
+
+	t := math.Max(0, math.Min(1, (px*bx+py*by)/length))
+
+	return math.Hypot(px-t*bx, py-t*by)
+}
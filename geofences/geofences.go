@@ -0,0 +1,100 @@
+package geofences
+
+import (
+	"errors"
+	"fmt"
+	"github.com/cobbinma/track-api/geo"
+	"github.com/cobbinma/track-api/graph/model"
+	"math"
+)
+
+const (
+	// maxRadius bounds the radius in metres of a circle fence.
+	maxRadius = 100000
+	// maxVertices bounds the vertices of a polygon fence.
+	maxVertices = 1000
+)
+
+var ErrInvalidGeofence = errors.New("invalid geofence")
+
+// Validate returns ErrInvalidGeofence unless the fence has a name and the fields its shape requires: a centre and
+// positive radius for a circle, or at least three vertices for a polygon.
+func Validate(fence *model.Geofence) error {
+	if fence.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidGeofence)
+	}
+
+	switch fence.Shape {
+	case model.GeofenceShapeCircle:
+		if fence.Center == nil || !validCoordinates(fence.Center) {
+			return fmt.Errorf("%w: circle requires a valid center", ErrInvalidGeofence)
+		}
+		if r := fence.Radius; r == nil || math.IsNaN(*r) || *r <= 0 || *r > maxRadius {
+			return fmt.Errorf("%w: circle requires a radius greater than 0 and at most %d", ErrInvalidGeofence,
+				maxRadius)
+		}
+	case model.GeofenceShapePolygon:
+		if n := len(fence.Polygon); n < 3 || n > maxVertices {
+			return fmt.Errorf("%w: polygon requires between 3 and %d vertices", ErrInvalidGeofence, maxVertices)
+		}
+		for _, c := range fence.Polygon {
+			if !validCoordinates(c) {
+				return fmt.Errorf("%w: polygon vertex %v, %v is out of bounds", ErrInvalidGeofence, c.Lat, c.Lng)
+			}
+		}
+	default:
+		return fmt.Errorf("%w: unsupported shape %s", ErrInvalidGeofence, fence.Shape)
+	}
+
+	return nil
+}
+
+// Contains reports whether the position is inside the fence.
+func Contains(fence *model.Geofence, position *model.Position) bool {
+	switch fence.Shape {
+	case model.GeofenceShapeCircle:
+		if fence.Center == nil || fence.Radius == nil {
+			return false
+		}
+		return geo.Distance(fence.Center.Lat, fence.Center.Lng, position.Lat, position.Lng) <= *fence.Radius
+	case model.GeofenceShapePolygon:
+		polygon := make([]geo.Point, 0, len(fence.Polygon))
+		for _, c := range fence.Polygon {
+			polygon = append(polygon, geo.Point{Lat: c.Lat, Lng: c.Lng})
+		}
+		return geo.InPolygon(geo.Point{Lat: position.Lat, Lng: position.Lng}, polygon)
+	default:
+		return false
+	}
+}
+
+// Crossings returns the fences the journey entered or left moving from the previous position through each of the
+// positions in turn. Nothing is crossed from a journey's first position, as where it started is not an entry. Only
+// the id, name and shape of each fence are given so that crossings stay small enough to publish, subscribers look up
+// the rest of the fence.
+func Crossings(fences []*model.Geofence, journeyID string, previous *model.Position,
+	positions ...*model.Position) []*model.GeofenceCrossing {
+	var crossings []*model.GeofenceCrossing
+	for _, p := range positions {
+		if previous != nil {
+			for _, fence := range fences {
+				if was, is := Contains(fence, previous), Contains(fence, p); was != is {
+					crossings = append(crossings, &model.GeofenceCrossing{
+						UserID:    fence.User.ID,
+						Entered:   is,
+						Geofence:  &model.Geofence{ID: fence.ID, Name: fence.Name, Shape: fence.Shape},
+						JourneyID: journeyID,
+						Position:  p,
+					})
+				}
+			}
+		}
+		previous = p
+	}
+
+	return crossings
+}
+
+func validCoordinates(c *model.Coordinates) bool {
+	return !math.IsNaN(c.Lat) && !math.IsNaN(c.Lng) && geo.ValidCoordinates(c.Lat, c.Lng)
+}
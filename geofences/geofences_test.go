@@ -0,0 +1,111 @@
+package geofences
+
+import (
+	"errors"
+	"github.com/cobbinma/track-api/graph/model"
+	"math"
+	"testing"
+)
+
+func circle(lat, lng, radius float64) *model.Geofence {
+	return &model.Geofence{
+		User:   &model.User{ID: "owner"},
+		Name:   "circle",
+		Shape:  model.GeofenceShapeCircle,
+		Center: &model.Coordinates{Lat: lat, Lng: lng},
+		Radius: &radius,
+	}
+}
+
+func polygon(vertices ...*model.Coordinates) *model.Geofence {
+	return &model.Geofence{
+		User:    &model.User{ID: "owner"},
+		Name:    "polygon",
+		Shape:   model.GeofenceShapePolygon,
+		Polygon: vertices,
+	}
+}
+
+func TestValidate(t *testing.T) {
+	square := []*model.Coordinates{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 1}, {Lat: 1, Lng: 1}, {Lat: 1, Lng: 0}}
+
+	valid := []*model.Geofence{circle(51.5, -0.12, 100), polygon(square...)}
+	for _, fence := range valid {
+		if err := Validate(fence); err != nil {
+			t.Errorf("expected %s fence to be valid, got %v", fence.Shape, err)
+		}
+	}
+
+	unnamed := circle(51.5, -0.12, 100)
+	unnamed.Name = ""
+	invalid := map[string]*model.Geofence{
+		"without a name":           unnamed,
+		"circle without a centre":  {Name: "circle", Shape: model.GeofenceShapeCircle, Radius: circle(0, 0, 1).Radius},
+		"circle out of bounds":     circle(91, 0, 100),
+		"circle without radius":    circle(51.5, -0.12, 0),
+		"circle with nan radius":   circle(51.5, -0.12, math.NaN()),
+		"circle too large":         circle(51.5, -0.12, maxRadius+1),
+		"polygon of two vertices":  polygon(square[:2]...),
+		"polygon out of bounds":    polygon(append(square[:3:3], &model.Coordinates{Lat: 0, Lng: 181})...),
+		"polygon of nan vertices":  polygon(append(square[:3:3], &model.Coordinates{Lat: math.NaN(), Lng: 0})...),
+		"polygon of many vertices": polygon(make([]*model.Coordinates, maxVertices+1)...),
+		"unsupported shape":        {Name: "line", Shape: model.GeofenceShape("LINE")},
+	}
+	for name, fence := range invalid {
+		if err := Validate(fence); !errors.Is(err, ErrInvalidGeofence) {
+			t.Errorf("expected fence %s to be invalid, got %v", name, err)
+		}
+	}
+}
+
+func TestContains(t *testing.T) {
+	fence := circle(0, 0, 100)
+	if !Contains(fence, &model.Position{Lat: 0, Lng: 0.0005}) {
+		t.Error("expected a position 56m from the centre to be inside a 100m circle")
+	}
+	if Contains(fence, &model.Position{Lat: 0, Lng: 0.001}) {
+		t.Error("expected a position 111m from the centre to be outside a 100m circle")
+	}
+
+	fence = polygon(&model.Coordinates{Lat: 0, Lng: 0}, &model.Coordinates{Lat: 0, Lng: 1},
+		&model.Coordinates{Lat: 1, Lng: 1}, &model.Coordinates{Lat: 1, Lng: 0})
+	if !Contains(fence, &model.Position{Lat: 0.5, Lng: 0.5}) {
+		t.Error("expected the centre of a square to be inside it")
+	}
+	if Contains(fence, &model.Position{Lat: 1.5, Lng: 0.5}) {
+		t.Error("expected a position north of a square to be outside it")
+	}
+}
+
+func TestCrossings(t *testing.T) {
+	home, work := circle(0, 0, 100), circle(0, 0.01, 100)
+	home.ID, work.ID = "home", "work"
+	fences := []*model.Geofence{home, work}
+
+	atHome := &model.Position{Lat: 0, Lng: 0}
+	between := &model.Position{Lat: 0, Lng: 0.005}
+	atWork := &model.Position{Lat: 0, Lng: 0.01}
+
+	if crossings := Crossings(fences, "journey", nil, atHome); len(crossings) != 0 {
+		t.Errorf("expected starting inside a fence not to enter it, got %d crossings", len(crossings))
+	}
+
+	crossings := Crossings(fences, "journey", atHome, between, atWork, atWork)
+	if len(crossings) != 2 {
+		t.Fatalf("expected leaving home and entering work, got %d crossings", len(crossings))
+	}
+	if c := crossings[0]; c.Geofence.ID != home.ID || c.Entered || c.Position != between {
+		t.Errorf("expected to leave home at the position between, got %+v", c)
+	}
+	if c := crossings[1]; c.Geofence.ID != work.ID || !c.Entered || c.Position != atWork {
+		t.Errorf("expected to enter work at the position there, got %+v", c)
+	}
+	for _, c := range crossings {
+		if c.JourneyID != "journey" || c.UserID != "owner" {
+			t.Errorf("expected a crossing of the journey for the fence owner, got %+v", c)
+		}
+		if c.Geofence.Name != "circle" || c.Geofence.Shape != model.GeofenceShapeCircle || c.Geofence.Center != nil {
+			t.Errorf("expected the crossing to carry a summary of the fence, got %+v", c.Geofence)
+		}
+	}
+}
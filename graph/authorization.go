@@ -0,0 +1,48 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/repositories"
+	"time"
+)
+
+// shareLinkContextKey holds the id of the share link a websocket connection was initialised with.
+type shareLinkContextKey struct{}
+
+// authorizeViewer returns ErrUnAuthorized unless the user owns the journey or it has been shared with them.
+func (r *Resolver) authorizeViewer(ctx context.Context, journey *model.Journey, userID string) error {
+	if journey.User.ID == userID {
+		return nil
+	}
+
+	ok, err := r.repository.IsViewer(ctx, journey.ID, userID)
+	if err != nil {
+		return fmt.Errorf("is viewer : %w", err)
+	}
+
+	if !ok {
+		return ErrUnAuthorized
+	}
+
+	return nil
+}
+
+// authorizeShareLink returns the share link if it grants access to the journey, otherwise ErrUnAuthorized.
+func (r *Resolver) authorizeShareLink(ctx context.Context, linkID, journeyID string) (*model.ShareLink, error) {
+	link, err := r.repository.GetShareLink(ctx, linkID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrUnAuthorized
+		}
+		return nil, fmt.Errorf("get share link : %w", err)
+	}
+
+	if link.JourneyID != journeyID || link.Revoked || !time.Now().Before(link.ExpiresAt) {
+		return nil, ErrUnAuthorized
+	}
+
+	return link, nil
+}
@@ -0,0 +1,127 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cobbinma/track-api/geo"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/stats"
+	"time"
+)
+
+const (
+	// recentSpeedWindow is how far back along the track the speed used to estimate arrival is measured.
+	recentSpeedWindow = 5 * time.Minute
+	// maxRecentPoints bounds the positions read, latest first, to measure the recent speed and time within arrival.
+	maxRecentPoints = 1000
+	// minArrivalSpeed is the slowest speed in metres per second an arrival time is estimated for, below it the
+	// journey is treated as stopped.
+	minArrivalSpeed = 0.1
+)
+
+// Arrival configures when a journey is considered to have arrived at its destination.
+type Arrival struct {
+	// Radius is the distance in metres from the destination within which the walker has arrived.
+	Radius float64
+	// Dwell is how long the walker must stay within the radius.
+	Dwell time.Duration
+}
+
+// estimateArrival sets the distance remaining to the journey's destination and when it will be reached at the speed of
+// the recent track, storing the estimate with the journey. It should be called after the journey's position is added.
+func (r *Resolver) estimateArrival(ctx context.Context, journey *model.Journey) error {
+	if journey.Destination == nil || journey.Position == nil || journey.Position.RecordedAt == nil {
+		return nil
+	}
+
+	position, destination := journey.Position, journey.Destination
+	remaining := geo.Distance(position.Lat, position.Lng, destination.Lat, destination.Lng)
+
+	since := position.RecordedAt.Add(-recentSpeedWindow)
+	points, err := r.repository.ListRecentPositions(ctx, journey.ID, since, maxRecentPoints)
+	if err != nil {
+		return fmt.Errorf("list recent positions : %w", err)
+	}
+
+	var eta *time.Time
+	if speed := stats.RecentSpeed(points); speed >= minArrivalSpeed {
+		t := position.RecordedAt.Add(time.Duration(remaining / speed * float64(time.Second)))
+		eta = &t
+	}
+
+	journey.RemainingDistance, journey.Eta = &remaining, eta
+	if err := r.repository.UpdateETA(ctx, journey.ID, journey.RemainingDistance, journey.Eta); err != nil {
+		return fmt.Errorf("update eta : %w", err)
+	}
+
+	return nil
+}
+
+// detectArrival completes the journey once the walker has stayed within the arrival radius of its destination for the
+// dwell time. It should be called after the update recording the journey's position, so subscribers see the position
+// that arrived before the journey completes.
+func (r *Resolver) detectArrival(ctx context.Context, journey *model.Journey) error {
+	if journey.Destination == nil || journey.Position == nil || journey.Position.RecordedAt == nil {
+		return nil
+	}
+
+	// reading back twice the dwell time finds when the walker came within the radius even when positions are sparse
+	since := journey.Position.RecordedAt.Add(-2 * r.arrival.Dwell)
+	points, err := r.repository.ListRecentPositions(ctx, journey.ID, since, maxRecentPoints)
+	if err != nil {
+		return fmt.Errorf("list recent positions : %w", err)
+	}
+
+	if within, ok := stats.TimeWithin(points, journey.Destination, r.arrival.Radius); !ok || within < r.arrival.Dwell {
+		return nil
+	}
+
+	// a walker who set off from within the radius has not arrived until they have left it and come back
+	left, err := r.leftDestination(ctx, journey, points)
+	if err != nil {
+		return err
+	}
+	if !left {
+		return nil
+	}
+
+	if err := r.updateStatus(ctx, journey, model.JourneyStatusComplete, model.JourneyEventArrived); err != nil {
+		return fmt.Errorf("update status : %w", err)
+	}
+
+	return nil
+}
+
+// errLeftDestination stops streaming a track once a position outside the arrival radius is found.
+var errLeftDestination = errors.New("left destination")
+
+// leftDestination reports whether any position of the journey's track is outside the arrival radius of its
+// destination, checking the recent points before reading the rest of the track.
+func (r *Resolver) leftDestination(ctx context.Context, journey *model.Journey,
+	recent []*model.TrackPoint) (bool, error) {
+	outside := func(p *model.TrackPoint) bool {
+		return geo.Distance(p.Lat, p.Lng, journey.Destination.Lat, journey.Destination.Lng) > r.arrival.Radius
+	}
+
+	for _, p := range recent {
+		if outside(p) {
+			return true, nil
+		}
+	}
+
+	err := r.repository.StreamPositions(ctx, journey.ID, func(p *model.TrackPoint) error {
+		if outside(p) {
+			return errLeftDestination
+		}
+		return nil
+	})
+	if errors.Is(err, errLeftDestination) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("stream positions : %w", err)
+	}
+
+	return false, nil
+}
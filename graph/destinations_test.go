@@ -0,0 +1,191 @@
+package graph
+
+import (
+	"github.com/cobbinma/track-api/geo"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/validation"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEstimateArrival(t *testing.T) {
+	r := newResolver()
+	journey, err := r.Mutation().CreateJourney(withSubject(owner), &model.NewCoordinates{Lat: 51.51, Lng: -0.12})
+	if err != nil {
+		t.Fatalf("unable to create journey: %v", err)
+	}
+
+	start := time.Now().UTC().Add(-time.Minute)
+	if _, err := r.Mutation().UpdateJourneyPosition(withSubject(owner), model.UpdateJourneyPosition{
+		ID:       journey.ID,
+		Position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: &start},
+	}); err != nil {
+		t.Fatalf("unable to update position: %v", err)
+	}
+
+	moved := start.Add(10 * time.Second)
+	got, err := r.Mutation().UpdateJourneyPosition(withSubject(owner), model.UpdateJourneyPosition{
+		ID:       journey.ID,
+		Position: &model.NewPosition{Lat: 51.5001, Lng: -0.12, RecordedAt: &moved},
+	})
+	if err != nil {
+		t.Fatalf("unable to update position: %v", err)
+	}
+
+	remaining := geo.Distance(51.5001, -0.12, 51.51, -0.12)
+	if got.RemainingDistance == nil || math.Abs(*got.RemainingDistance-remaining) > 1e-6 {
+		t.Fatalf("expected %.1fm remaining, got %v", remaining, got.RemainingDistance)
+	}
+
+	speed := geo.Distance(51.5, -0.12, 51.5001, -0.12) / 10
+	eta := moved.Add(time.Duration(remaining / speed * float64(time.Second)))
+	if got.Eta == nil || math.Abs(got.Eta.Sub(eta).Seconds()) > 1e-3 {
+		t.Errorf("expected to arrive at %s, got %v", eta, got.Eta)
+	}
+
+	stored, err := r.Query().Journey(withSubject(owner), journey.ID)
+	if err != nil {
+		t.Fatalf("unable to get journey: %v", err)
+	}
+	if stored.Eta == nil || !stored.Eta.Equal(*got.Eta) {
+		t.Errorf("expected the estimate to be stored, got %v", stored.Eta)
+	}
+}
+
+func TestEstimateArrivalWhenStopped(t *testing.T) {
+	r := newResolver()
+	journey, err := r.Mutation().CreateJourney(withSubject(owner), &model.NewCoordinates{Lat: 51.51, Lng: -0.12})
+	if err != nil {
+		t.Fatalf("unable to create journey: %v", err)
+	}
+
+	now := time.Now().UTC()
+	got, err := r.Mutation().UpdateJourneyPosition(withSubject(owner), model.UpdateJourneyPosition{
+		ID:       journey.ID,
+		Position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: &now},
+	})
+	if err != nil {
+		t.Fatalf("unable to update position: %v", err)
+	}
+
+	if got.RemainingDistance == nil {
+		t.Error("expected the remaining distance to be known")
+	}
+	if got.Eta != nil {
+		t.Errorf("expected no arrival time for a journey that is not moving, got %s", got.Eta)
+	}
+}
+
+func TestCreateJourneyRejectsInvalidDestination(t *testing.T) {
+	r := newResolver()
+
+	_, err := r.Mutation().CreateJourney(withSubject(owner), &model.NewCoordinates{Lat: 91, Lng: 0})
+	if code(err) != string(validation.CodeInvalidCoordinates) {
+		t.Errorf("expected code %s, got %v", validation.CodeInvalidCoordinates, err)
+	}
+}
+
+// walk creates a journey to the destination and records positions at the given coordinates, a minute apart and ending
+// now, returning the journey after the last position.
+func walk(t *testing.T, r *Resolver, destination *model.NewCoordinates, track ...model.NewPosition) *model.Journey {
+	t.Helper()
+
+	journey, err := r.Mutation().CreateJourney(withSubject(owner), destination)
+	if err != nil {
+		t.Fatalf("unable to create journey: %v", err)
+	}
+
+	start := time.Now().UTC().Add(-time.Duration(len(track)-1) * time.Minute)
+	for i := range track {
+		p := track[i]
+		recordedAt := start.Add(time.Duration(i) * time.Minute)
+		p.RecordedAt = &recordedAt
+
+		if journey, err = r.Mutation().UpdateJourneyPosition(withSubject(owner),
+			model.UpdateJourneyPosition{ID: journey.ID, Position: &p}); err != nil {
+			t.Fatalf("unable to update position %d: %v", i, err)
+		}
+	}
+
+	return journey
+}
+
+func TestDetectArrival(t *testing.T) {
+	r := newResolver()
+
+	journey := walk(t, r, &model.NewCoordinates{Lat: 51.51, Lng: -0.12},
+		model.NewPosition{Lat: 51.5, Lng: -0.12},
+		model.NewPosition{Lat: 51.51, Lng: -0.12},
+		model.NewPosition{Lat: 51.51005, Lng: -0.12},
+	)
+
+	if journey.Status != model.JourneyStatusComplete {
+		t.Fatalf("expected the journey to complete after dwelling at its destination, got %s", journey.Status)
+	}
+	if journey.Event == nil || *journey.Event != model.JourneyEventArrived {
+		t.Errorf("expected the journey to have arrived, got %v", journey.Event)
+	}
+
+	stored, err := r.Query().Journey(withSubject(owner), journey.ID)
+	if err != nil {
+		t.Fatalf("unable to get journey: %v", err)
+	}
+	if stored.Status != model.JourneyStatusComplete {
+		t.Errorf("expected the completed status to be stored, got %s", stored.Status)
+	}
+}
+
+func TestDetectArrivalWaitsForDwell(t *testing.T) {
+	r := newResolver()
+	r.arrival.Dwell = 2 * time.Minute
+
+	journey := walk(t, r, &model.NewCoordinates{Lat: 51.51, Lng: -0.12},
+		model.NewPosition{Lat: 51.5, Lng: -0.12},
+		model.NewPosition{Lat: 51.51, Lng: -0.12},
+		model.NewPosition{Lat: 51.51005, Lng: -0.12},
+	)
+
+	if journey.Status != model.JourneyStatusActive {
+		t.Errorf("expected the journey to stay active until the walker has dwelt at the destination, got %s",
+			journey.Status)
+	}
+}
+
+func TestDetectArrivalWaitsForWalkerToLeave(t *testing.T) {
+	r := newResolver()
+	destination := &model.NewCoordinates{Lat: 51.51, Lng: -0.12}
+
+	journey := walk(t, r, destination,
+		model.NewPosition{Lat: 51.51, Lng: -0.12},
+		model.NewPosition{Lat: 51.51005, Lng: -0.12},
+		model.NewPosition{Lat: 51.51, Lng: -0.12},
+	)
+	if journey.Status != model.JourneyStatusActive {
+		t.Errorf("expected a journey starting at its destination to stay active, got %s", journey.Status)
+	}
+
+	journey = walk(t, r, destination,
+		model.NewPosition{Lat: 51.51, Lng: -0.12},
+		model.NewPosition{Lat: 51.5, Lng: -0.12},
+		model.NewPosition{Lat: 51.51, Lng: -0.12},
+		model.NewPosition{Lat: 51.51005, Lng: -0.12},
+	)
+	if journey.Status != model.JourneyStatusComplete {
+		t.Errorf("expected the journey to complete once the walker has left and come back, got %s", journey.Status)
+	}
+}
+
+func TestDetectArrivalWithoutDestination(t *testing.T) {
+	r := newResolver()
+
+	journey := walk(t, r, nil,
+		model.NewPosition{Lat: 51.51, Lng: -0.12},
+		model.NewPosition{Lat: 51.51, Lng: -0.12},
+		model.NewPosition{Lat: 51.51, Lng: -0.12},
+	)
+
+	if journey.Status != model.JourneyStatusActive {
+		t.Errorf("expected a journey without a destination to stay active, got %s", journey.Status)
+	}
+}
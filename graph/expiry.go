@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/repositories"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+// expiryBatchSize bounds the journeys read at a time when looking for idle journeys.
+const expiryBatchSize = 100
+
+// Expiry configures when an active journey is considered abandoned.
+type Expiry struct {
+	// After is how long an active journey may go without its position or status changing before it is completed.
+	After time.Duration
+	// Interval is how often idle journeys are looked for.
+	Interval time.Duration
+}
+
+// RunExpiry completes active journeys that have been idle for longer than the expiry allows until ctx is done.
+func (r *Resolver) RunExpiry(ctx context.Context, expiry Expiry) {
+	ticker := time.NewTicker(expiry.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.expireJourneys(ctx, time.Now().Add(-expiry.After)); err != nil {
+			log.Error().Err(err).Msg("unable to expire journeys")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// expireJourneys completes the active journeys last updated before the given time, clearing their positions and
+// telling subscribers they expired.
+func (r *Resolver) expireJourneys(ctx context.Context, before time.Time) error {
+	for {
+		journeys, err := r.repository.ListIdleJourneys(ctx, before, expiryBatchSize)
+		if err != nil {
+			return fmt.Errorf("list idle journeys : %w", err)
+		}
+
+		for _, journey := range journeys {
+			expired, err := r.expireJourney(ctx, journey.ID, before)
+			if err != nil {
+				return fmt.Errorf("expire journey : %w", err)
+			}
+			if expired {
+				log.Info().Str("journeyId", journey.ID).Msg("expired idle journey")
+			}
+		}
+		if len(journeys) > 0 {
+			r.relay.Wake()
+		}
+
+		if len(journeys) < expiryBatchSize {
+			return nil
+		}
+	}
+}
+
+// expireJourney completes the journey if it is still active and was last updated before the given time, reporting
+// whether it was completed. The journey is read again and locked as it may have been updated, or expired by another
+// instance, since it was listed.
+func (r *Resolver) expireJourney(ctx context.Context, id string, before time.Time) (bool, error) {
+	var expired bool
+	err := r.repository.Transaction(ctx, func(ctx context.Context) error {
+		journey, err := r.repository.GetJourneyForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return nil
+			}
+			return fmt.Errorf("get journey for update : %w", err)
+		}
+
+		if journey.Status != model.JourneyStatusActive || !journey.UpdatedAt.Before(before) {
+			return nil
+		}
+		expired = true
+
+		return r.updateStatus(ctx, journey, model.JourneyStatusComplete, model.JourneyEventExpired)
+	})
+
+	return expired, err
+}
@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"context"
+	"github.com/cobbinma/track-api/graph/model"
+	"testing"
+	"time"
+)
+
+func TestExpireJourneys(t *testing.T) {
+	r := newResolver()
+	idle := createJourney(t, r)
+	paused := createJourney(t, r)
+	setStatus(t, r, paused.ID, model.JourneyStatusPaused)
+
+	ctx, cancel := context.WithCancel(withSubject(owner))
+	defer cancel()
+	updates, err := r.Subscription().Journey(ctx, idle.ID, nil)
+	if err != nil {
+		t.Fatalf("unable to subscribe to journey: %v", err)
+	}
+	next(t, updates)
+	go r.relay.Run(ctx)
+
+	time.Sleep(time.Millisecond)
+	before := time.Now()
+	recent := createJourney(t, r)
+
+	if err := r.expireJourneys(context.Background(), before); err != nil {
+		t.Fatalf("unable to expire journeys: %v", err)
+	}
+
+	expected := map[string]model.JourneyStatus{
+		idle.ID:   model.JourneyStatusComplete,
+		paused.ID: model.JourneyStatusPaused,
+		recent.ID: model.JourneyStatusActive,
+	}
+	for id, status := range expected {
+		journey, err := r.Query().Journey(withSubject(owner), id)
+		if err != nil {
+			t.Fatalf("unable to get journey: %v", err)
+		}
+		if journey.Status != status {
+			t.Errorf("expected journey %s to be %s, got %s", id, status, journey.Status)
+		}
+	}
+
+	j := next(t, updates)
+	if j == nil || j.Status != model.JourneyStatusComplete || j.Event == nil || *j.Event != model.JourneyEventExpired {
+		t.Errorf("expected subscribers to be told the journey expired, got %+v", j)
+	}
+}
+
+func TestExpireJourneysInBatches(t *testing.T) {
+	r := newResolver()
+	for i := 0; i < expiryBatchSize+expiryBatchSize/2; i++ {
+		createJourney(t, r)
+	}
+
+	if err := r.expireJourneys(context.Background(), time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("unable to expire journeys: %v", err)
+	}
+
+	idle, err := r.repository.ListIdleJourneys(context.Background(), time.Now().Add(time.Minute), expiryBatchSize)
+	if err != nil {
+		t.Fatalf("unable to list idle journeys: %v", err)
+	}
+	if len(idle) != 0 {
+		t.Errorf("expected every idle journey to expire, %d are still active", len(idle))
+	}
+}
+
+func TestExpireJourneyUpdatedSinceListed(t *testing.T) {
+	r := newResolver()
+	journey := createJourney(t, r)
+
+	// the journey is idle when listed, then updated before it is expired
+	time.Sleep(time.Millisecond)
+	before := time.Now()
+	now := time.Now().UTC()
+	if _, err := r.Mutation().UpdateJourneyPosition(withSubject(owner), model.UpdateJourneyPosition{
+		ID:       journey.ID,
+		Position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: &now},
+	}); err != nil {
+		t.Fatalf("unable to update position: %v", err)
+	}
+
+	expired, err := r.expireJourney(context.Background(), journey.ID, before)
+	if err != nil {
+		t.Fatalf("unable to expire journey: %v", err)
+	}
+	if expired {
+		t.Error("expected a journey updated since it was listed not to expire")
+	}
+}
@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	jwtmiddleware "github.com/auth0/go-jwt-middleware/v2"
+	"github.com/auth0/go-jwt-middleware/v2/validator"
+	"github.com/cobbinma/track-api/formats"
+	"github.com/cobbinma/track-api/repositories"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// baseURLContextKey holds the scheme and host that export urls given in responses to a request are relative to.
+type baseURLContextKey struct{}
+
+// withBaseURL sets the base url of the request's context to the public url if given, otherwise to the scheme and
+// host the request was made to.
+func withBaseURL(publicURL string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			baseURL := strings.TrimSuffix(publicURL, "/")
+			if baseURL == "" {
+				baseURL = c.Scheme() + "://" + c.Request().Host
+			}
+
+			c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), baseURLContextKey{}, baseURL)))
+			return next(c)
+		}
+	}
+}
+
+func exportPath(journeyID string) string {
+	return fmt.Sprintf("/journeys/%s/export", journeyID)
+}
+
+// exportURL returns the url the journey's track is exported from in the format, absolute when the context carries a
+// base url. The export requires the same bearer token as the API.
+func exportURL(ctx context.Context, journeyID string, format formats.Format) string {
+	baseURL, _ := ctx.Value(baseURLContextKey{}).(string)
+	return baseURL + exportPath(journeyID) + "?" + url.Values{"format": {string(format)}}.Encode()
+}
+
+// exportFormat returns the format given by the format query parameter, otherwise the first supported format in the
+// accept header, defaulting to GPX.
+func exportFormat(c echo.Context) (formats.Format, error) {
+	if f := c.QueryParam("format"); f != "" {
+		return formats.ParseFormat(f)
+	}
+
+	if f, ok := formats.Negotiate(c.Request().Header.Get(echo.HeaderAccept)); ok {
+		return f, nil
+	}
+
+	return formats.GPX, nil
+}
+
+// export streams the journey's track in the negotiated format.
+func (r *Resolver) export(c echo.Context) error {
+	format, err := exportFormat(c)
+	if err != nil {
+		log.Warn().Err(err).Msg("unsupported export format")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	journey, err := r.repository.GetJourney(ctx, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		log.Error().Err(err).Msg("unable to get journey from repository")
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	if err := r.authorizeViewer(ctx, journey, claims.RegisteredClaims.Subject); err != nil {
+		if errors.Is(err, ErrUnAuthorized) {
+			log.Warn().Str("subject", claims.RegisteredClaims.Subject).Str("journeyId", journey.ID).
+				Msg("unauthorized subject attempting to export journey")
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+		log.Error().Err(err).Str("journeyId", journey.ID).Msg("unable to authorize viewer")
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	enc, err := format.NewEncoder(c.Response())
+	if err != nil {
+		log.Error().Err(err).Msg("unable to create encoder")
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, format.ContentType())
+	c.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf(`attachment; filename="%s.%s"`, journey.ID, format.Extension()))
+	c.Response().WriteHeader(http.StatusOK)
+
+	// the status has been sent, so failures part way through can only be logged
+	if err := enc.Begin(journey); err != nil {
+		log.Error().Err(err).Str("journeyId", journey.ID).Str("format", string(format)).Msg("unable to begin export")
+		return nil
+	}
+
+	if err := r.repository.StreamPositions(ctx, journey.ID, enc.Point); err != nil {
+		log.Error().Err(err).Str("journeyId", journey.ID).Msg("unable to stream positions from repository")
+		return nil
+	}
+
+	if err := enc.End(); err != nil {
+		log.Error().Err(err).Str("journeyId", journey.ID).Str("format", string(format)).Msg("unable to end export")
+	}
+
+	return nil
+}
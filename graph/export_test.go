@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"context"
+	"github.com/cobbinma/track-api/formats"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExportURL(t *testing.T) {
+	for name, tt := range map[string]struct {
+		publicURL string
+		expected  string
+	}{
+		"request host": {expected: "http://api.example.com/journeys/id/export?format=gpx"},
+		"public url": {
+			publicURL: "https://track.example.com/",
+			expected:  "https://track.example.com/journeys/id/export?format=gpx",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var got string
+			handler := withBaseURL(tt.publicURL)(func(c echo.Context) error {
+				got = exportURL(c.Request().Context(), "id", formats.GPX)
+				return nil
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "http://api.example.com/query", nil)
+			if err := handler(echo.New().NewContext(req, httptest.NewRecorder())); err != nil {
+				t.Fatalf("unable to handle request: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+
+	if got := exportURL(context.Background(), "id", formats.GPX); got != "/journeys/id/export?format=gpx" {
+		t.Errorf("expected a relative url without a base url, got %s", got)
+	}
+}
+
+func TestGpxURL(t *testing.T) {
+	r := newResolver()
+	journey := &model.Journey{ID: "id"}
+
+	gpx, err := r.Journey().GpxURL(context.Background(), journey)
+	if err != nil {
+		t.Fatalf("unable to get gpx url: %v", err)
+	}
+	export, err := r.Journey().ExportURL(context.Background(), journey, model.ExportFormatGpx)
+	if err != nil {
+		t.Fatalf("unable to get export url: %v", err)
+	}
+	if gpx != export {
+		t.Errorf("expected the gpx url to be the GPX export url %s, got %s", export, gpx)
+	}
+}
@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cobbinma/track-api/geofences"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/repositories"
+	"github.com/rs/zerolog/log"
+)
+
+func newGeofence(id, userID string, input model.NewGeofence) *model.Geofence {
+	fence := &model.Geofence{
+		ID:     id,
+		User:   &model.User{ID: userID},
+		Name:   input.Name,
+		Shape:  input.Shape,
+		Radius: input.Radius,
+	}
+	if input.Center != nil {
+		fence.Center = &model.Coordinates{Lat: input.Center.Lat, Lng: input.Center.Lng}
+	}
+	for _, c := range input.Polygon {
+		fence.Polygon = append(fence.Polygon, &model.Coordinates{Lat: c.Lat, Lng: c.Lng})
+	}
+
+	return fence
+}
+
+// evaluateGeofences records a crossing for each geofence of the journey's owner or viewers that the journey entered or
+// left moving from the previous position through the positions.
+func (r *Resolver) evaluateGeofences(ctx context.Context, journey *model.Journey, previous *model.Position,
+	positions ...*model.Position) error {
+	viewers, err := r.repository.ListViewers(ctx, journey.ID)
+	if err != nil {
+		return fmt.Errorf("list viewers : %w", err)
+	}
+
+	fences, err := r.repository.ListGeofences(ctx, append([]string{journey.User.ID}, viewers...))
+	if err != nil {
+		return fmt.Errorf("list geofences : %w", err)
+	}
+
+	for _, crossing := range geofences.Crossings(fences, journey.ID, previous, positions...) {
+		if err := r.repository.AddGeofenceOutboxEvent(ctx, crossing); err != nil {
+			return fmt.Errorf("add geofence outbox event : %w", err)
+		}
+	}
+
+	return nil
+}
+
+// resolveCrossing returns the crossing with the summary of the fence it carries replaced by the whole fence. A fence
+// that has since been deleted, or cannot be read, is left as the summary.
+func (r *Resolver) resolveCrossing(ctx context.Context, crossing *model.GeofenceCrossing) *model.GeofenceCrossing {
+	resolved := *crossing
+
+	fence, err := r.repository.GetGeofence(ctx, crossing.Geofence.ID)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrNotFound) {
+			log.Error().Err(err).Str("geofenceId", crossing.Geofence.ID).Msg("unable to get geofence from repository")
+		}
+		summary := *crossing.Geofence
+		summary.User = &model.User{ID: crossing.UserID}
+		resolved.Geofence = &summary
+
+		return &resolved
+	}
+	resolved.Geofence = fence
+
+	return &resolved
+}
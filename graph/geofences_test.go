@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"github.com/cobbinma/track-api/graph/model"
+	"testing"
+	"time"
+)
+
+func TestGeofenceEvents(t *testing.T) {
+	r := newResolver()
+	journey := createJourney(t, r)
+	if _, err := r.Mutation().ShareJourney(withSubject(owner), journey.ID, stranger); err != nil {
+		t.Fatalf("unable to share journey: %v", err)
+	}
+
+	radius := 100.0
+	fence, err := r.Mutation().CreateGeofence(withSubject(stranger), model.NewGeofence{
+		Name:   "home",
+		Shape:  model.GeofenceShapeCircle,
+		Center: &model.NewCoordinates{Lat: 51.51, Lng: -0.12},
+		Radius: &radius,
+	})
+	if err != nil {
+		t.Fatalf("unable to create geofence: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(withSubject(stranger))
+	defer cancel()
+	events, err := r.Subscription().GeofenceEvents(ctx)
+	if err != nil {
+		t.Fatalf("unable to subscribe to geofence events: %v", err)
+	}
+	go r.relay.Run(ctx)
+
+	start := time.Now().UTC().Add(-time.Minute)
+	arrived := start.Add(time.Minute)
+	for _, p := range []*model.NewPosition{
+		{Lat: 51.5, Lng: -0.12, RecordedAt: &start},
+		{Lat: 51.51, Lng: -0.12, RecordedAt: &arrived},
+	} {
+		if _, err := r.Mutation().UpdateJourneyPosition(withSubject(owner),
+			model.UpdateJourneyPosition{ID: journey.ID, Position: p}); err != nil {
+			t.Fatalf("unable to update position: %v", err)
+		}
+	}
+
+	select {
+	case event := <-events:
+		entered, ok := event.(*model.GeofenceEntered)
+		if !ok {
+			t.Fatalf("expected the viewer's fence to be entered, got %#v", event)
+		}
+		if entered.Geofence.ID != fence.ID || entered.JourneyID != journey.ID || entered.Position.Lat != 51.51 {
+			t.Errorf("expected journey %s to enter fence %s at 51.51, got %+v", journey.ID, fence.ID, entered)
+		}
+		if r := entered.Geofence.Radius; r == nil || *r != radius {
+			t.Errorf("expected the whole fence to be given, got %+v", entered.Geofence)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a geofence event")
+	}
+}
+
+func TestGeofenceOwnership(t *testing.T) {
+	r := newResolver()
+
+	radius := 100.0
+	input := model.NewGeofence{
+		Name:   "home",
+		Shape:  model.GeofenceShapeCircle,
+		Center: &model.NewCoordinates{Lat: 51.51, Lng: -0.12},
+		Radius: &radius,
+	}
+	fence, err := r.Mutation().CreateGeofence(withSubject(owner), input)
+	if err != nil {
+		t.Fatalf("unable to create geofence: %v", err)
+	}
+
+	if _, err := r.Query().Geofence(withSubject(stranger), fence.ID); !errors.Is(err, ErrUnAuthorized) {
+		t.Errorf("expected another user to be unable to see the geofence, got %v", err)
+	}
+	if _, err := r.Mutation().UpdateGeofence(withSubject(stranger), fence.ID, input); !errors.Is(err, ErrUnAuthorized) {
+		t.Errorf("expected another user to be unable to update the geofence, got %v", err)
+	}
+	if _, err := r.Mutation().DeleteGeofence(withSubject(stranger), fence.ID); !errors.Is(err, ErrUnAuthorized) {
+		t.Errorf("expected another user to be unable to delete the geofence, got %v", err)
+	}
+
+	input.Radius = nil
+	if _, err := r.Mutation().UpdateGeofence(withSubject(owner), fence.ID, input); !errors.Is(err, ErrBadRequest) {
+		t.Errorf("expected a circle without a radius to be a bad request, got %v", err)
+	}
+
+	if _, err := r.Mutation().DeleteGeofence(withSubject(owner), fence.ID); err != nil {
+		t.Fatalf("unable to delete geofence: %v", err)
+	}
+	if _, err := r.Query().Geofence(withSubject(owner), fence.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a deleted geofence not to be found, got %v", err)
+	}
+}
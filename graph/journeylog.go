@@ -0,0 +1,25 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	jwtmiddleware "github.com/auth0/go-jwt-middleware/v2"
+	"github.com/auth0/go-jwt-middleware/v2/validator"
+	"github.com/cobbinma/track-api/graph/model"
+	"time"
+)
+
+// logEvent appends the change to its journey's log, recording the authenticated user as the one who made it. Changes
+// made without a user, such as those made by the server, are recorded without an actor.
+func (r *Resolver) logEvent(ctx context.Context, event *model.JourneyLogEvent) error {
+	event.CreatedAt = time.Now().UTC()
+	if claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims); ok {
+		event.Actor = &model.User{ID: claims.RegisteredClaims.Subject}
+	}
+
+	if err := r.repository.AddJourneyLogEvent(ctx, event); err != nil {
+		return fmt.Errorf("add journey log event : %w", err)
+	}
+
+	return nil
+}
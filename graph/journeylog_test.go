@@ -0,0 +1,77 @@
+package graph
+
+import (
+	"errors"
+	"github.com/cobbinma/track-api/graph/model"
+	"testing"
+	"time"
+)
+
+func TestJourneyEvents(t *testing.T) {
+	r := newResolver()
+	journey := createJourney(t, r)
+
+	now := time.Now().UTC()
+	if _, err := r.Mutation().UpdateJourneyPosition(withSubject(owner), model.UpdateJourneyPosition{
+		ID:       journey.ID,
+		Position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: &now},
+	}); err != nil {
+		t.Fatalf("unable to update position: %v", err)
+	}
+	if _, err := r.Mutation().ShareJourney(withSubject(owner), journey.ID, stranger); err != nil {
+		t.Fatalf("unable to share journey: %v", err)
+	}
+	setStatus(t, r, journey.ID, model.JourneyStatusPaused)
+
+	if _, err := r.Journey().Events(withSubject(stranger), journey, nil, nil); !errors.Is(err, ErrUnAuthorized) {
+		t.Errorf("expected a viewer to be unable to see the journey's events, got %v", err)
+	}
+
+	first := 3
+	page, err := r.Journey().Events(withSubject(owner), journey, &first, nil)
+	if err != nil {
+		t.Fatalf("unable to list events: %v", err)
+	}
+	if len(page.Edges) != 3 || !page.PageInfo.HasNextPage {
+		t.Fatalf("expected a first page of 3 events with more to come, got %d", len(page.Edges))
+	}
+
+	rest, err := r.Journey().Events(withSubject(owner), journey, &first, page.PageInfo.EndCursor)
+	if err != nil {
+		t.Fatalf("unable to list events: %v", err)
+	}
+	if len(rest.Edges) != 1 || rest.PageInfo.HasNextPage {
+		t.Fatalf("expected the last event on the second page, got %d", len(rest.Edges))
+	}
+
+	var events []*model.JourneyLogEvent
+	for _, edge := range append(page.Edges, rest.Edges...) {
+		events = append(events, edge.Node)
+	}
+
+	expected := []model.JourneyLogAction{
+		model.JourneyLogActionCreated,
+		model.JourneyLogActionPositionUpdated,
+		model.JourneyLogActionShared,
+		model.JourneyLogActionStatusChanged,
+	}
+	for i, action := range expected {
+		if events[i].Action != action {
+			t.Errorf("expected event %d to be %s, got %s", i, action, events[i].Action)
+		}
+		if events[i].Actor == nil || events[i].Actor.ID != owner {
+			t.Errorf("expected event %d to be made by the owner, got %+v", i, events[i].Actor)
+		}
+	}
+
+	if p := events[1].Position; p == nil || p.Lat != 51.5 {
+		t.Errorf("expected the position update to record the position, got %+v", p)
+	}
+	if v := events[2].Viewer; v == nil || v.ID != stranger {
+		t.Errorf("expected the share to record the viewer, got %+v", v)
+	}
+	if e := events[3]; e.PreviousStatus == nil || *e.PreviousStatus != model.JourneyStatusActive ||
+		e.Status == nil || *e.Status != model.JourneyStatusPaused {
+		t.Errorf("expected the status change from ACTIVE to PAUSED, got %v to %v", e.PreviousStatus, e.Status)
+	}
+}
@@ -0,0 +1,62 @@
+package model
+
+import "time"
+
+type Journey struct {
+	ID                string        `json:"id"`
+	User              *User         `json:"user"`
+	Status            JourneyStatus `json:"status"`
+	Position          *Position     `json:"position"`
+	Version           int           `json:"version"`
+	Event             *JourneyEvent `json:"event,omitempty"`
+	Stats             *JourneyStats `json:"stats"`
+	Destination       *Coordinates  `json:"destination"`
+	RemainingDistance *float64      `json:"remainingDistance"`
+	Eta               *time.Time    `json:"eta"`
+	CreatedAt         time.Time     `json:"createdAt"`
+	CompletedAt       *time.Time    `json:"completedAt"`
+	UpdatedAt         time.Time     `json:"updatedAt"`
+}
+
+// StartedAt returns when the first position of the journey was recorded.
+func (j *Journey) StartedAt() *time.Time {
+	if j.Stats == nil {
+		return nil
+	}
+
+	return j.Stats.StartedAt
+}
+
+// JourneyLogEvent is a change made to a journey, recorded in the journey's append-only log. Seq orders the events of
+// all journeys.
+type JourneyLogEvent struct {
+	Seq            int64            `json:"seq"`
+	JourneyID      string           `json:"journeyId"`
+	Action         JourneyLogAction `json:"action"`
+	Actor          *User            `json:"actor"`
+	PreviousStatus *JourneyStatus   `json:"previousStatus"`
+	Status         *JourneyStatus   `json:"status"`
+	Event          *JourneyEvent    `json:"event"`
+	Position       *Position        `json:"position"`
+	Viewer         *User            `json:"viewer"`
+	ShareLinkID    *string          `json:"shareLinkId"`
+	CreatedAt      time.Time        `json:"createdAt"`
+}
+
+// GeofenceCrossing is a journey entering or leaving a geofence, delivered to the owner of the geofence.
+type GeofenceCrossing struct {
+	UserID    string    `json:"userId"`
+	Entered   bool      `json:"entered"`
+	Geofence  *Geofence `json:"geofence"`
+	JourneyID string    `json:"journeyId"`
+	Position  *Position `json:"position"`
+}
+
+// Event returns the crossing as the event sent to subscribers.
+func (c *GeofenceCrossing) Event() GeofenceEvent {
+	if c.Entered {
+		return &GeofenceEntered{Geofence: c.Geofence, JourneyID: c.JourneyID, Position: c.Position}
+	}
+
+	return &GeofenceExited{Geofence: c.Geofence, JourneyID: c.JourneyID, Position: c.Position}
+}
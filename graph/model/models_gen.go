@@ -6,23 +6,163 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
-type Journey struct {
-	ID       string        `json:"id"`
-	User     *User         `json:"user"`
-	Status   JourneyStatus `json:"status"`
-	Position *Position     `json:"position"`
+type GeofenceEvent interface {
+	IsGeofenceEvent()
+}
+
+type Coordinates struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+type Geofence struct {
+	ID    string        `json:"id"`
+	User  *User         `json:"user"`
+	Name  string        `json:"name"`
+	Shape GeofenceShape `json:"shape"`
+	// centre of a CIRCLE fence
+	Center *Coordinates `json:"center"`
+	// radius in metres of a CIRCLE fence
+	Radius *float64 `json:"radius"`
+	// vertices of a POLYGON fence
+	Polygon []*Coordinates `json:"polygon"`
+}
+
+type GeofenceEntered struct {
+	Geofence  *Geofence `json:"geofence"`
+	JourneyID string    `json:"journeyId"`
+	Position  *Position `json:"position"`
+}
+
+func (GeofenceEntered) IsGeofenceEvent() {}
+
+type GeofenceExited struct {
+	Geofence  *Geofence `json:"geofence"`
+	JourneyID string    `json:"journeyId"`
+	Position  *Position `json:"position"`
+}
+
+func (GeofenceExited) IsGeofenceEvent() {}
+
+type JourneyConnection struct {
+	Edges    []*JourneyEdge `json:"edges"`
+	PageInfo *PageInfo      `json:"pageInfo"`
+}
+
+type JourneyEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Journey `json:"node"`
+}
+
+type JourneyLogConnection struct {
+	Edges    []*JourneyLogEdge `json:"edges"`
+	PageInfo *PageInfo         `json:"pageInfo"`
+}
+
+type JourneyLogEdge struct {
+	Cursor string           `json:"cursor"`
+	Node   *JourneyLogEvent `json:"node"`
+}
+
+type JourneyStats struct {
+	DistanceMeters  float64 `json:"distanceMeters"`
+	DurationSeconds float64 `json:"durationSeconds"`
+	// average speed in metres per second
+	AverageSpeed float64 `json:"averageSpeed"`
+	// fastest speed in metres per second
+	MaxSpeed  float64    `json:"maxSpeed"`
+	StartedAt *time.Time `json:"startedAt"`
+	EndedAt   *time.Time `json:"endedAt"`
+}
+
+type NewCoordinates struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+type NewGeofence struct {
+	Name    string            `json:"name"`
+	Shape   GeofenceShape     `json:"shape"`
+	Center  *NewCoordinates   `json:"center"`
+	Radius  *float64          `json:"radius"`
+	Polygon []*NewCoordinates `json:"polygon"`
 }
 
 type NewPosition struct {
 	Lat float64 `json:"lat"`
 	Lng float64 `json:"lng"`
+	// horizontal accuracy in metres
+	Accuracy *float64 `json:"accuracy"`
+	// altitude in metres above sea level
+	Altitude *float64 `json:"altitude"`
+	// speed in metres per second
+	Speed *float64 `json:"speed"`
+	// heading in degrees clockwise from true north
+	Heading *float64 `json:"heading"`
+	// when the position was recorded by the client, the time it is received is used when not given
+	RecordedAt *time.Time `json:"recordedAt"`
+}
+
+type PageInfo struct {
+	EndCursor   *string `json:"endCursor"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
+type Path struct {
+	Encoding PathEncoding `json:"encoding"`
+	// number of points in the simplified path
+	PointCount int `json:"pointCount"`
+	// google encoded polyline of the path when encoded as POLYLINE
+	Polyline *string `json:"polyline"`
+	// points of the path when encoded as COORDINATES
+	Coordinates []*Coordinates `json:"coordinates"`
 }
 
 type Position struct {
 	Lat float64 `json:"lat"`
 	Lng float64 `json:"lng"`
+	// horizontal accuracy in metres
+	Accuracy *float64 `json:"accuracy"`
+	// altitude in metres above sea level
+	Altitude *float64 `json:"altitude"`
+	// speed in metres per second
+	Speed *float64 `json:"speed"`
+	// heading in degrees clockwise from true north
+	Heading *float64 `json:"heading"`
+	// when the position was recorded by the client
+	RecordedAt *time.Time `json:"recordedAt"`
+}
+
+type ShareLink struct {
+	ID        string    `json:"id"`
+	JourneyID string    `json:"journeyId"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Revoked   bool      `json:"revoked"`
+}
+
+type TrackConnection struct {
+	Edges    []*TrackEdge `json:"edges"`
+	PageInfo *PageInfo    `json:"pageInfo"`
+}
+
+type TrackEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *TrackPoint `json:"node"`
+}
+
+type TrackPoint struct {
+	Seq        int       `json:"seq"`
+	Lat        float64   `json:"lat"`
+	Lng        float64   `json:"lng"`
+	Accuracy   *float64  `json:"accuracy"`
+	Altitude   *float64  `json:"altitude"`
+	Speed      *float64  `json:"speed"`
+	Heading    *float64  `json:"heading"`
+	RecordedAt time.Time `json:"recordedAt"`
 }
 
 type UpdateJourneyPosition struct {
@@ -39,21 +179,250 @@ type User struct {
 	ID string `json:"id"`
 }
 
+type ExportFormat string
+
+const (
+	ExportFormatGpx     ExportFormat = "GPX"
+	ExportFormatGeojson ExportFormat = "GEOJSON"
+	ExportFormatKml     ExportFormat = "KML"
+)
+
+var AllExportFormat = []ExportFormat{
+	ExportFormatGpx,
+	ExportFormatGeojson,
+	ExportFormatKml,
+}
+
+func (e ExportFormat) IsValid() bool {
+	switch e {
+	case ExportFormatGpx, ExportFormatGeojson, ExportFormatKml:
+		return true
+	}
+	return false
+}
+
+func (e ExportFormat) String() string {
+	return string(e)
+}
+
+func (e *ExportFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExportFormat", str)
+	}
+	return nil
+}
+
+func (e ExportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type GeofenceShape string
+
+const (
+	GeofenceShapeCircle  GeofenceShape = "CIRCLE"
+	GeofenceShapePolygon GeofenceShape = "POLYGON"
+)
+
+var AllGeofenceShape = []GeofenceShape{
+	GeofenceShapeCircle,
+	GeofenceShapePolygon,
+}
+
+func (e GeofenceShape) IsValid() bool {
+	switch e {
+	case GeofenceShapeCircle, GeofenceShapePolygon:
+		return true
+	}
+	return false
+}
+
+func (e GeofenceShape) String() string {
+	return string(e)
+}
+
+func (e *GeofenceShape) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GeofenceShape(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GeofenceShape", str)
+	}
+	return nil
+}
+
+func (e GeofenceShape) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ImportFormat string
+
+const (
+	ImportFormatGpx     ImportFormat = "GPX"
+	ImportFormatTcx     ImportFormat = "TCX"
+	ImportFormatGeojson ImportFormat = "GEOJSON"
+)
+
+var AllImportFormat = []ImportFormat{
+	ImportFormatGpx,
+	ImportFormatTcx,
+	ImportFormatGeojson,
+}
+
+func (e ImportFormat) IsValid() bool {
+	switch e {
+	case ImportFormatGpx, ImportFormatTcx, ImportFormatGeojson:
+		return true
+	}
+	return false
+}
+
+func (e ImportFormat) String() string {
+	return string(e)
+}
+
+func (e *ImportFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ImportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ImportFormat", str)
+	}
+	return nil
+}
+
+func (e ImportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type JourneyEvent string
+
+const (
+	JourneyEventPositionUpdated JourneyEvent = "POSITION_UPDATED"
+	JourneyEventStatusChanged   JourneyEvent = "STATUS_CHANGED"
+	JourneyEventArrived         JourneyEvent = "ARRIVED"
+	JourneyEventExpired         JourneyEvent = "EXPIRED"
+)
+
+var AllJourneyEvent = []JourneyEvent{
+	JourneyEventPositionUpdated,
+	JourneyEventStatusChanged,
+	JourneyEventArrived,
+	JourneyEventExpired,
+}
+
+func (e JourneyEvent) IsValid() bool {
+	switch e {
+	case JourneyEventPositionUpdated, JourneyEventStatusChanged, JourneyEventArrived, JourneyEventExpired:
+		return true
+	}
+	return false
+}
+
+func (e JourneyEvent) String() string {
+	return string(e)
+}
+
+func (e *JourneyEvent) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = JourneyEvent(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid JourneyEvent", str)
+	}
+	return nil
+}
+
+func (e JourneyEvent) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type JourneyLogAction string
+
+const (
+	JourneyLogActionCreated          JourneyLogAction = "CREATED"
+	JourneyLogActionImported         JourneyLogAction = "IMPORTED"
+	JourneyLogActionStatusChanged    JourneyLogAction = "STATUS_CHANGED"
+	JourneyLogActionPositionUpdated  JourneyLogAction = "POSITION_UPDATED"
+	JourneyLogActionShared           JourneyLogAction = "SHARED"
+	JourneyLogActionShareRevoked     JourneyLogAction = "SHARE_REVOKED"
+	JourneyLogActionShareLinkCreated JourneyLogAction = "SHARE_LINK_CREATED"
+	JourneyLogActionShareLinkRevoked JourneyLogAction = "SHARE_LINK_REVOKED"
+)
+
+var AllJourneyLogAction = []JourneyLogAction{
+	JourneyLogActionCreated,
+	JourneyLogActionImported,
+	JourneyLogActionStatusChanged,
+	JourneyLogActionPositionUpdated,
+	JourneyLogActionShared,
+	JourneyLogActionShareRevoked,
+	JourneyLogActionShareLinkCreated,
+	JourneyLogActionShareLinkRevoked,
+}
+
+func (e JourneyLogAction) IsValid() bool {
+	switch e {
+	case JourneyLogActionCreated, JourneyLogActionImported, JourneyLogActionStatusChanged, JourneyLogActionPositionUpdated, JourneyLogActionShared, JourneyLogActionShareRevoked, JourneyLogActionShareLinkCreated, JourneyLogActionShareLinkRevoked:
+		return true
+	}
+	return false
+}
+
+func (e JourneyLogAction) String() string {
+	return string(e)
+}
+
+func (e *JourneyLogAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = JourneyLogAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid JourneyLogAction", str)
+	}
+	return nil
+}
+
+func (e JourneyLogAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type JourneyStatus string
 
 const (
-	JourneyStatusActive   JourneyStatus = "ACTIVE"
-	JourneyStatusComplete JourneyStatus = "COMPLETE"
+	JourneyStatusActive    JourneyStatus = "ACTIVE"
+	JourneyStatusPaused    JourneyStatus = "PAUSED"
+	JourneyStatusComplete  JourneyStatus = "COMPLETE"
+	JourneyStatusCancelled JourneyStatus = "CANCELLED"
 )
 
 var AllJourneyStatus = []JourneyStatus{
 	JourneyStatusActive,
+	JourneyStatusPaused,
 	JourneyStatusComplete,
+	JourneyStatusCancelled,
 }
 
 func (e JourneyStatus) IsValid() bool {
 	switch e {
-	case JourneyStatusActive, JourneyStatusComplete:
+	case JourneyStatusActive, JourneyStatusPaused, JourneyStatusComplete, JourneyStatusCancelled:
 		return true
 	}
 	return false
@@ -79,3 +448,44 @@ func (e *JourneyStatus) UnmarshalGQL(v interface{}) error {
 func (e JourneyStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type PathEncoding string
+
+const (
+	PathEncodingPolyline    PathEncoding = "POLYLINE"
+	PathEncodingCoordinates PathEncoding = "COORDINATES"
+)
+
+var AllPathEncoding = []PathEncoding{
+	PathEncodingPolyline,
+	PathEncodingCoordinates,
+}
+
+func (e PathEncoding) IsValid() bool {
+	switch e {
+	case PathEncodingPolyline, PathEncodingCoordinates:
+		return true
+	}
+	return false
+}
+
+func (e PathEncoding) String() string {
+	return string(e)
+}
+
+func (e *PathEncoding) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PathEncoding(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PathEncoding", str)
+	}
+	return nil
+}
+
+func (e PathEncoding) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,74 @@
+package graph
+
+import (
+	"encoding/base64"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/repositories"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+func pageSize(first *int) (int, error) {
+	if first == nil {
+		return defaultPageSize, nil
+	}
+
+	if *first < 1 || *first > maxPageSize {
+		return 0, ErrBadRequest
+	}
+
+	return *first, nil
+}
+
+func encodeCursor(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func decodeCursor(cursor *string) (*string, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	key, err := base64.RawURLEncoding.DecodeString(*cursor)
+	if err != nil {
+		return nil, ErrBadRequest
+	}
+
+	s := string(key)
+	return &s, nil
+}
+
+// encodeJourneyCursor returns the cursor of the journey in its owner's history, which is ordered by when journeys
+// were created and then by id.
+func encodeJourneyCursor(journey *model.Journey) string {
+	return encodeCursor(journey.CreatedAt.Format(time.RFC3339Nano) + "," + journey.ID)
+}
+
+func decodeJourneyCursor(cursor *string) (*repositories.JourneyCursor, error) {
+	key, err := decodeCursor(cursor)
+	if err != nil || key == nil {
+		return nil, err
+	}
+
+	parts := strings.SplitN(*key, ",", 2)
+	if len(parts) != 2 {
+		return nil, ErrBadRequest
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, ErrBadRequest
+	}
+
+	if _, err := uuid.Parse(parts[1]); err != nil {
+		return nil, ErrBadRequest
+	}
+
+	return &repositories.JourneyCursor{CreatedAt: createdAt, ID: parts[1]}, nil
+}
@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"errors"
+	"github.com/cobbinma/track-api/graph/model"
+	"testing"
+)
+
+func TestMyJourneysPages(t *testing.T) {
+	r := newResolver()
+	var created []*model.Journey
+	for i := 0; i < 3; i++ {
+		journey := createJourney(t, r)
+		setStatus(t, r, journey.ID, model.JourneyStatusComplete)
+		created = append(created, journey)
+	}
+
+	first := 2
+	page, err := r.Query().MyJourneys(withSubject(owner), nil, &first, nil)
+	if err != nil {
+		t.Fatalf("unable to list journeys: %v", err)
+	}
+	if len(page.Edges) != 2 || !page.PageInfo.HasNextPage {
+		t.Fatalf("expected a first page of 2 journeys with more to come, got %d", len(page.Edges))
+	}
+
+	rest, err := r.Query().MyJourneys(withSubject(owner), nil, &first, page.PageInfo.EndCursor)
+	if err != nil {
+		t.Fatalf("unable to list journeys: %v", err)
+	}
+	if len(rest.Edges) != 1 || rest.PageInfo.HasNextPage {
+		t.Fatalf("expected the last journey on the second page, got %d", len(rest.Edges))
+	}
+
+	// journeys are listed newest first
+	edges := append(page.Edges, rest.Edges...)
+	for i, edge := range edges {
+		if expected := created[len(created)-1-i]; edge.Node.ID != expected.ID {
+			t.Errorf("expected journey %d to be %s, got %s", i, expected.ID, edge.Node.ID)
+		}
+	}
+
+	if others, err := r.Query().MyJourneys(withSubject(stranger), nil, nil, nil); err != nil || len(others.Edges) != 0 {
+		t.Errorf("expected another user to have no journeys, got %v, %v", others, err)
+	}
+}
+
+func TestMyJourneysInvalidPage(t *testing.T) {
+	r := newResolver()
+
+	tooMany := maxPageSize + 1
+	if _, err := r.Query().MyJourneys(withSubject(owner), nil, &tooMany, nil); !errors.Is(err, ErrBadRequest) {
+		t.Errorf("expected a page over the maximum size to be a bad request, got %v", err)
+	}
+
+	for _, cursor := range []string{"not base64!", encodeCursor("yesterday,id"), encodeCursor("2021-06-01T09:00:00Z,id")} {
+		if _, err := r.Query().MyJourneys(withSubject(owner), nil, nil, &cursor); !errors.Is(err, ErrBadRequest) {
+			t.Errorf("expected cursor %q to be a bad request, got %v", cursor, err)
+		}
+	}
+}
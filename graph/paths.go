@@ -0,0 +1,34 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/cobbinma/track-api/geo"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/paths"
+)
+
+// pathCacheSize is the number of simplified paths kept in memory.
+const pathCacheSize = 1000
+
+// simplifiedPath returns the journey's track simplified to the tolerance, reading the track only when the path for
+// this version of the journey is not cached.
+func (r *Resolver) simplifiedPath(ctx context.Context, journey *model.Journey, tolerance float64) ([]geo.Point, error) {
+	key := paths.Key{JourneyID: journey.ID, Version: journey.Version, Tolerance: tolerance}
+	if points, ok := r.paths.Get(key); ok {
+		return points, nil
+	}
+
+	points := []geo.Point{}
+	if err := r.repository.StreamPositions(ctx, journey.ID, func(point *model.TrackPoint) error {
+		points = append(points, geo.Point{Lat: point.Lat, Lng: point.Lng})
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("stream positions : %w", err)
+	}
+
+	points = geo.Simplify(points, tolerance)
+	r.paths.Add(key, points)
+
+	return points, nil
+}
@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/lifecycle"
+	"github.com/cobbinma/track-api/stats"
+	"github.com/cobbinma/track-api/validation"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"time"
+)
+
+// maxAppendPositions bounds the positions appended at once so they are inserted in a single statement.
+const maxAppendPositions = 1000
+
+var positionUpdated = model.JourneyEventPositionUpdated
+
+// errNotTracking is returned when a journey stops being tracked before the positions sent for it are recorded.
+var errNotTracking = fmt.Errorf("journey is not tracked")
+
+// lockTrackedJourney reads the journey again within the current transaction, locking it so that positions sent at the
+// same time are validated and counted against each other rather than against the same earlier read. errNotTracking is
+// returned if the journey is no longer tracked.
+func (r *Resolver) lockTrackedJourney(ctx context.Context, id string) (*model.Journey, error) {
+	journey, err := r.repository.GetJourneyForUpdate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get journey for update : %w", err)
+	}
+
+	if !lifecycle.Tracking(journey.Status) {
+		return nil, errNotTracking
+	}
+
+	return journey, nil
+}
+
+// newPosition returns the position reported by the client, recorded at the given time unless the client recorded
+// when it was taken.
+func newPosition(p *model.NewPosition, now time.Time) *model.Position {
+	position := &model.Position{
+		Lat:        p.Lat,
+		Lng:        p.Lng,
+		Accuracy:   p.Accuracy,
+		Altitude:   p.Altitude,
+		Speed:      p.Speed,
+		Heading:    p.Heading,
+		RecordedAt: p.RecordedAt,
+	}
+	if position.RecordedAt == nil {
+		position.RecordedAt = &now
+	}
+
+	return position
+}
+
+func trackPoint(p *model.Position) *model.TrackPoint {
+	return &model.TrackPoint{
+		Lat:        p.Lat,
+		Lng:        p.Lng,
+		Accuracy:   p.Accuracy,
+		Altitude:   p.Altitude,
+		Speed:      p.Speed,
+		Heading:    p.Heading,
+		RecordedAt: *p.RecordedAt,
+	}
+}
+
+// addStats returns the statistics of the journey after the points are recorded following its current position.
+func addStats(journey *model.Journey, points ...*model.TrackPoint) *model.JourneyStats {
+	var previous *model.TrackPoint
+	if p := journey.Position; p != nil && p.RecordedAt != nil {
+		previous = trackPoint(p)
+	}
+
+	s := journey.Stats
+	for _, point := range points {
+		s = stats.Add(s, previous, point)
+		previous = point
+	}
+
+	return s
+}
+
+// rejectedPosition returns the error describing why a position failed validation, with its code as an extension so
+// clients can tell rejections apart.
+func rejectedPosition(err error) error {
+	var vErr *validation.Error
+	if !errors.As(err, &vErr) {
+		return ErrBadRequest
+	}
+
+	return &gqlerror.Error{
+		Message:    vErr.Message,
+		Extensions: map[string]interface{}{"code": string(vErr.Code)},
+	}
+}
@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"sort"
+)
+
+// maxReplayVersions bounds the versions replayed to a subscriber resuming from an earlier version, clients further
+// behind should fetch the journey again instead.
+const maxReplayVersions = 100
+
+// errResyncRequired is returned when the versions a subscriber missed cannot be replayed, either because there are
+// too many of them or because they are no longer retained.
+var errResyncRequired = fmt.Errorf("resync required")
+
+// replay returns the versions of the journey a subscriber should be sent before live updates, oldest first, ending
+// with the journey as it was read. Versions committed between reading the journey and subscribing are included along
+// with any the client missed since the version it gave. errResyncRequired is returned if the missed versions cannot
+// all be replayed.
+func (r *Resolver) replay(ctx context.Context, journey *model.Journey, since *int) ([]*model.Journey, error) {
+	from := journey.Version
+	if since != nil && *since < from {
+		if from-*since > maxReplayVersions {
+			return nil, errResyncRequired
+		}
+		from = *since
+	}
+
+	missed, err := r.repository.ListJourneyVersions(ctx, journey.ID, from, maxReplayVersions)
+	if err != nil {
+		return nil, fmt.Errorf("list journey versions : %w", err)
+	}
+
+	// the first version is recorded when the journey is created rather than in the outbox, so the client needs every
+	// version after it and before the one read
+	first := from + 1
+	if first < 2 {
+		first = 2
+	}
+	if first < journey.Version && (len(missed) == 0 || missed[0].Version != first) {
+		return nil, errResyncRequired
+	}
+
+	missed = append(missed, journey)
+	sort.SliceStable(missed, func(i, j int) bool { return missed[i].Version < missed[j].Version })
+
+	return missed, nil
+}
+
+// resyncRequired returns the error telling a subscriber to fetch the journey again before subscribing from its
+// current version, with the code as an extension so clients can detect it.
+func resyncRequired(journey *model.Journey) error {
+	return &gqlerror.Error{
+		Message: "missed versions can no longer be replayed, fetch the journey and subscribe from its current version",
+		Extensions: map[string]interface{}{
+			"code":    "RESYNC_REQUIRED",
+			"version": journey.Version,
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package graph
+
+import (
+	"context"
+	"github.com/cobbinma/track-api/graph/model"
+	"testing"
+	"time"
+)
+
+// move records positions at the journey's start, a second apart and ending now, returning the journey's version.
+func move(t *testing.T, r *Resolver, journey *model.Journey, positions int) int {
+	t.Helper()
+
+	start := time.Now().UTC().Add(-time.Duration(positions) * time.Second)
+	for i := 0; i < positions; i++ {
+		recordedAt := start.Add(time.Duration(i) * time.Second)
+		j, err := r.Mutation().UpdateJourneyPosition(withSubject(owner), model.UpdateJourneyPosition{
+			ID:       journey.ID,
+			Position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: &recordedAt},
+		})
+		if err != nil {
+			t.Fatalf("unable to update position: %v", err)
+		}
+		journey = j
+	}
+
+	return journey.Version
+}
+
+func TestSubscribeReplaysMissedVersions(t *testing.T) {
+	r := newResolver()
+	journey := createJourney(t, r)
+	version := move(t, r, journey, 3)
+
+	ctx, cancel := context.WithCancel(withSubject(owner))
+	defer cancel()
+	since := journey.Version
+	updates, err := r.Subscription().Journey(ctx, journey.ID, &since)
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	for v := since + 1; v <= version; v++ {
+		if j := next(t, updates); j == nil || j.Version != v {
+			t.Fatalf("expected version %d to be replayed, got %+v", v, j)
+		}
+	}
+}
+
+func TestSubscribeTooFarBehind(t *testing.T) {
+	r := newResolver()
+	journey := createJourney(t, r)
+	move(t, r, journey, maxReplayVersions+1)
+
+	since := journey.Version
+	if _, err := r.Subscription().Journey(withSubject(owner), journey.ID, &since); code(err) != "RESYNC_REQUIRED" {
+		t.Errorf("expected code RESYNC_REQUIRED, got %v", err)
+	}
+}
@@ -2,10 +2,12 @@
 package graph
 
 import (
-	"github.com/ably/ably-go/ably"
-	"github.com/cobbinma/track-api/repositories/postgres"
-	"github.com/rs/zerolog/log"
-	"os"
+	"github.com/cobbinma/track-api/brokers"
+	"github.com/cobbinma/track-api/outbox"
+	"github.com/cobbinma/track-api/paths"
+	"github.com/cobbinma/track-api/repositories"
+	"github.com/cobbinma/track-api/sharelinks"
+	"github.com/cobbinma/track-api/validation"
 )
 
 // This file will not be regenerated automatically.
@@ -13,23 +15,24 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	queue      *ably.Realtime
-	repository *postgres.Client
+	arrival    Arrival
+	broker     brokers.Broker
+	paths      *paths.Cache
+	relay      *outbox.Relay
+	repository repositories.Repository
+	signer     *sharelinks.Signer
+	validator  *validation.Validator
 }
 
-func NewResolver(repository *postgres.Client) *Resolver {
-	key := os.Getenv("ABLY_API_KEY")
-	if key == "" {
-		log.Fatal().Str("key", "ABLY_API_KEY").Msg("key not given")
-	}
-
-	queue, err := ably.NewRealtime(ably.WithKey(key))
-	if err != nil {
-		log.Fatal().Err(err).Msg("unable to create ably client")
-	}
-
+func NewResolver(repository repositories.Repository, broker brokers.Broker, relay *outbox.Relay,
+	signer *sharelinks.Signer, validator *validation.Validator, arrival Arrival) *Resolver {
 	return &Resolver{
-		queue:      queue,
+		arrival:    arrival,
+		broker:     broker,
+		paths:      paths.NewCache(pathCacheSize),
+		relay:      relay,
 		repository: repository,
+		signer:     signer,
+		validator:  validator,
 	}
 }
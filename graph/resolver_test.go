@@ -0,0 +1,503 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	jwtmiddleware "github.com/auth0/go-jwt-middleware/v2"
+	"github.com/auth0/go-jwt-middleware/v2/validator"
+	bm "github.com/cobbinma/track-api/brokers/memory"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/lifecycle"
+	"github.com/cobbinma/track-api/outbox"
+	rm "github.com/cobbinma/track-api/repositories/memory"
+	"github.com/cobbinma/track-api/sharelinks"
+	"github.com/cobbinma/track-api/validation"
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"testing"
+	"time"
+)
+
+const (
+	owner    = "owner"
+	stranger = "stranger"
+)
+
+func newResolver() *Resolver {
+	repository := rm.NewMemory()
+	broker := bm.NewMemory()
+
+	return NewResolver(repository, broker, outbox.NewRelay(repository, broker, time.Second),
+		sharelinks.NewSigner([]byte("secret")), validation.NewValidator(50, time.Minute),
+		Arrival{Radius: 25, Dwell: time.Minute})
+}
+
+func withSubject(subject string) context.Context {
+	claims := &validator.ValidatedClaims{}
+	claims.RegisteredClaims.Subject = subject
+	return context.WithValue(context.Background(), jwtmiddleware.ContextKey{}, claims)
+}
+
+// createJourney creates an active journey owned by owner.
+func createJourney(t *testing.T, r *Resolver) *model.Journey {
+	t.Helper()
+
+	journey, err := r.Mutation().CreateJourney(withSubject(owner), nil)
+	if err != nil {
+		t.Fatalf("unable to create journey: %v", err)
+	}
+
+	return journey
+}
+
+// setStatus moves the journey through each status, failing the test if any step is rejected.
+func setStatus(t *testing.T, r *Resolver, id string, statuses ...model.JourneyStatus) {
+	t.Helper()
+
+	for _, status := range statuses {
+		if _, err := r.Mutation().UpdateJourneyStatus(withSubject(owner),
+			model.UpdateJourneyStatus{ID: id, Status: status}); err != nil {
+			t.Fatalf("unable to set status %s: %v", status, err)
+		}
+	}
+}
+
+// code returns the code extension of a gqlerror, or an empty string for any other error.
+func code(err error) string {
+	var gErr *gqlerror.Error
+	if !errors.As(err, &gErr) {
+		return ""
+	}
+
+	c, _ := gErr.Extensions["code"].(string)
+	return c
+}
+
+func TestUpdateJourneyStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous []model.JourneyStatus
+		status   model.JourneyStatus
+		code     string
+	}{
+		{name: "pause", status: model.JourneyStatusPaused},
+		{name: "resume", previous: []model.JourneyStatus{model.JourneyStatusPaused}, status: model.JourneyStatusActive},
+		{name: "complete", status: model.JourneyStatusComplete},
+		{
+			name:     "cancel while paused",
+			previous: []model.JourneyStatus{model.JourneyStatusPaused},
+			status:   model.JourneyStatusCancelled,
+		},
+		{name: "unchanged", status: model.JourneyStatusActive},
+		{
+			name:     "reopen completed",
+			previous: []model.JourneyStatus{model.JourneyStatusComplete},
+			status:   model.JourneyStatusActive,
+			code:     "INVALID_TRANSITION",
+		},
+		{
+			name:     "pause cancelled",
+			previous: []model.JourneyStatus{model.JourneyStatusCancelled},
+			status:   model.JourneyStatusPaused,
+			code:     "INVALID_TRANSITION",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newResolver()
+			journey := createJourney(t, r)
+			setStatus(t, r, journey.ID, tt.previous...)
+
+			got, err := r.Mutation().UpdateJourneyStatus(withSubject(owner),
+				model.UpdateJourneyStatus{ID: journey.ID, Status: tt.status})
+			if tt.code != "" {
+				if code(err) != tt.code {
+					t.Fatalf("expected code %s, got %v", tt.code, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.Status != tt.status {
+				t.Errorf("expected status %s, got %s", tt.status, got.Status)
+			}
+			if final := lifecycle.Final(tt.status); final != (got.CompletedAt != nil) {
+				t.Errorf("expected completed at to be set only in a final status, got %v", got.CompletedAt)
+			}
+
+			stored, err := r.Query().Journey(withSubject(owner), journey.ID)
+			if err != nil {
+				t.Fatalf("unable to get journey: %v", err)
+			}
+			if stored.Status != tt.status {
+				t.Errorf("expected stored status %s, got %s", tt.status, stored.Status)
+			}
+		})
+	}
+}
+
+// staleRepository reads journeys as they were when stale was taken, as if they changed after they were read. Journeys
+// read for update are current.
+type staleRepository struct {
+	*rm.Client
+	stale map[string]model.Journey
+}
+
+func (r *staleRepository) GetJourney(ctx context.Context, id string) (*model.Journey, error) {
+	if journey, ok := r.stale[id]; ok {
+		return &journey, nil
+	}
+	return r.Client.GetJourney(ctx, id)
+}
+
+func TestUpdateJourneyStatusChecksCurrentStatus(t *testing.T) {
+	repository := &staleRepository{Client: rm.NewMemory(), stale: map[string]model.Journey{}}
+	broker := bm.NewMemory()
+	r := NewResolver(repository, broker, outbox.NewRelay(repository, broker, time.Second),
+		sharelinks.NewSigner([]byte("secret")), validation.NewValidator(50, time.Minute),
+		Arrival{Radius: 25, Dwell: time.Minute})
+
+	journey := createJourney(t, r)
+	repository.stale[journey.ID] = *journey
+	setStatus(t, r, journey.ID, model.JourneyStatusCancelled)
+
+	// the journey was read while active, but it was cancelled before it could be paused
+	_, err := r.Mutation().UpdateJourneyStatus(withSubject(owner),
+		model.UpdateJourneyStatus{ID: journey.ID, Status: model.JourneyStatusPaused})
+	if code(err) != "INVALID_TRANSITION" {
+		t.Fatalf("expected the transition to be checked against the cancelled journey, got %v", err)
+	}
+
+	stored, err := repository.Client.GetJourney(context.Background(), journey.ID)
+	if err != nil {
+		t.Fatalf("unable to get journey: %v", err)
+	}
+	if stored.Status != model.JourneyStatusCancelled {
+		t.Errorf("expected the journey to stay cancelled, got %s", stored.Status)
+	}
+}
+
+func TestAuthorization(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(ctx context.Context, r *Resolver, journey *model.Journey) error
+	}{
+		{
+			name: "journey",
+			call: func(ctx context.Context, r *Resolver, journey *model.Journey) error {
+				_, err := r.Query().Journey(ctx, journey.ID)
+				return err
+			},
+		},
+		{
+			name: "events",
+			call: func(ctx context.Context, r *Resolver, journey *model.Journey) error {
+				_, err := r.Journey().Events(ctx, journey, nil, nil)
+				return err
+			},
+		},
+		{
+			name: "subscribe",
+			call: func(ctx context.Context, r *Resolver, journey *model.Journey) error {
+				_, err := r.Subscription().Journey(ctx, journey.ID, nil)
+				return err
+			},
+		},
+		{
+			name: "update status",
+			call: func(ctx context.Context, r *Resolver, journey *model.Journey) error {
+				_, err := r.Mutation().UpdateJourneyStatus(ctx,
+					model.UpdateJourneyStatus{ID: journey.ID, Status: model.JourneyStatusComplete})
+				return err
+			},
+		},
+		{
+			name: "update position",
+			call: func(ctx context.Context, r *Resolver, journey *model.Journey) error {
+				_, err := r.Mutation().UpdateJourneyPosition(ctx,
+					model.UpdateJourneyPosition{ID: journey.ID, Position: &model.NewPosition{Lat: 51.5, Lng: -0.12}})
+				return err
+			},
+		},
+		{
+			name: "share",
+			call: func(ctx context.Context, r *Resolver, journey *model.Journey) error {
+				_, err := r.Mutation().ShareJourney(ctx, journey.ID, stranger)
+				return err
+			},
+		},
+		{
+			name: "revoke share",
+			call: func(ctx context.Context, r *Resolver, journey *model.Journey) error {
+				_, err := r.Mutation().RevokeJourneyShare(ctx, journey.ID, stranger)
+				return err
+			},
+		},
+		{
+			name: "create share link",
+			call: func(ctx context.Context, r *Resolver, journey *model.Journey) error {
+				_, err := r.Mutation().CreateShareLink(ctx, journey.ID, time.Now().Add(time.Hour))
+				return err
+			},
+		},
+	}
+
+	subjects := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{name: "another user", ctx: withSubject(stranger)},
+		{name: "without claims", ctx: context.Background()},
+	}
+
+	for _, tt := range tests {
+		for _, subject := range subjects {
+			t.Run(tt.name+" as "+subject.name, func(t *testing.T) {
+				r := newResolver()
+				journey := createJourney(t, r)
+
+				if err := tt.call(subject.ctx, r, journey); !errors.Is(err, ErrUnAuthorized) {
+					t.Errorf("expected ErrUnAuthorized, got %v", err)
+				}
+			})
+		}
+	}
+}
+
+func TestShareJourney(t *testing.T) {
+	r := newResolver()
+	journey := createJourney(t, r)
+
+	if _, err := r.Mutation().ShareJourney(withSubject(owner), journey.ID, owner); !errors.Is(err, ErrBadRequest) {
+		t.Errorf("expected sharing with the owner to be a bad request, got %v", err)
+	}
+
+	if _, err := r.Mutation().ShareJourney(withSubject(owner), journey.ID, stranger); err != nil {
+		t.Fatalf("unable to share journey: %v", err)
+	}
+	if _, err := r.Query().Journey(withSubject(stranger), journey.ID); err != nil {
+		t.Fatalf("expected viewer to see journey, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(withSubject(stranger))
+	defer cancel()
+	updates, err := r.Subscription().Journey(ctx, journey.ID, nil)
+	if err != nil {
+		t.Fatalf("expected viewer to subscribe to journey, got %v", err)
+	}
+	select {
+	case j := <-updates:
+		if j.ID != journey.ID {
+			t.Errorf("expected journey %s, got %s", journey.ID, j.ID)
+		}
+	case <-time.After(time.Second):
+		t.Error("expected the subscription to start with the current journey")
+	}
+
+	if _, err := r.Mutation().UpdateJourneyStatus(withSubject(stranger),
+		model.UpdateJourneyStatus{ID: journey.ID, Status: model.JourneyStatusComplete}); !errors.Is(err, ErrUnAuthorized) {
+		t.Errorf("expected viewer to be unable to update status, got %v", err)
+	}
+
+	if _, err := r.Mutation().RevokeJourneyShare(withSubject(owner), journey.ID, stranger); err != nil {
+		t.Fatalf("unable to revoke share: %v", err)
+	}
+	if _, err := r.Query().Journey(withSubject(stranger), journey.ID); !errors.Is(err, ErrUnAuthorized) {
+		t.Errorf("expected revoked viewer to be unauthorized, got %v", err)
+	}
+}
+
+func TestSubscribeUnknownJourney(t *testing.T) {
+	r := newResolver()
+
+	if _, err := r.Subscription().Journey(withSubject(owner), uuid.New().String(), nil); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected subscribing to an unknown journey to be not found, got %v", err)
+	}
+}
+
+// next returns the next update from a subscription, or nil once it has ended.
+func next(t *testing.T, updates <-chan *model.Journey) *model.Journey {
+	t.Helper()
+
+	select {
+	case j := <-updates:
+		return j
+	case <-time.After(time.Second):
+		t.Fatal("expected an update or the subscription to end")
+		return nil
+	}
+}
+
+func TestShareLink(t *testing.T) {
+	r := newResolver()
+	journey := createJourney(t, r)
+	other := createJourney(t, r)
+
+	if _, err := r.Mutation().CreateShareLink(withSubject(owner), journey.ID,
+		time.Now().Add(-time.Minute)); !errors.Is(err, ErrBadRequest) {
+		t.Errorf("expected a link that has already expired to be a bad request, got %v", err)
+	}
+
+	link, err := r.Mutation().CreateShareLink(withSubject(owner), journey.ID, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unable to create share link: %v", err)
+	}
+	if id, err := r.signer.Verify(link.Token); err != nil || id != link.ID {
+		t.Errorf("expected the token to be signed for link %s, got %s, %v", link.ID, id, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), shareLinkContextKey{}, link.ID))
+	defer cancel()
+
+	if _, err := r.Subscription().Journey(ctx, other.ID, nil); !errors.Is(err, ErrUnAuthorized) {
+		t.Errorf("expected the link not to grant access to another journey, got %v", err)
+	}
+	if _, err := r.Query().Journey(ctx, journey.ID); !errors.Is(err, ErrUnAuthorized) {
+		t.Errorf("expected the link to grant access to the subscription only, got %v", err)
+	}
+
+	updates, err := r.Subscription().Journey(ctx, journey.ID, nil)
+	if err != nil {
+		t.Fatalf("expected the link to grant access to the journey, got %v", err)
+	}
+	if j := next(t, updates); j == nil || j.ID != journey.ID {
+		t.Fatalf("expected the subscription to start with the current journey, got %+v", j)
+	}
+
+	if _, err := r.Mutation().RevokeShareLink(withSubject(stranger), link.ID); !errors.Is(err, ErrUnAuthorized) {
+		t.Errorf("expected another user to be unable to revoke the link, got %v", err)
+	}
+	if _, err := r.Mutation().RevokeShareLink(withSubject(owner), link.ID); err != nil {
+		t.Fatalf("unable to revoke share link: %v", err)
+	}
+	if _, err := r.Subscription().Journey(ctx, journey.ID, nil); !errors.Is(err, ErrUnAuthorized) {
+		t.Errorf("expected a revoked link to be unauthorized, got %v", err)
+	}
+
+	if _, err := r.Mutation().UpdateJourneyPosition(withSubject(owner),
+		model.UpdateJourneyPosition{ID: journey.ID, Position: &model.NewPosition{Lat: 51.5, Lng: -0.12}}); err != nil {
+		t.Fatalf("unable to update position: %v", err)
+	}
+	go r.relay.Run(ctx)
+	if j := next(t, updates); j != nil {
+		t.Errorf("expected the subscription to end once the link was revoked, got %+v", j)
+	}
+}
+
+func TestShareLinkExpiry(t *testing.T) {
+	r := newResolver()
+	journey := createJourney(t, r)
+
+	link, err := r.Mutation().CreateShareLink(withSubject(owner), journey.ID, time.Now().Add(100*time.Millisecond))
+	if err != nil {
+		t.Fatalf("unable to create share link: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), shareLinkContextKey{}, link.ID))
+	defer cancel()
+
+	updates, err := r.Subscription().Journey(ctx, journey.ID, nil)
+	if err != nil {
+		t.Fatalf("expected the link to grant access to the journey, got %v", err)
+	}
+	if j := next(t, updates); j == nil {
+		t.Fatal("expected the subscription to start with the current journey")
+	}
+	if j := next(t, updates); j != nil {
+		t.Errorf("expected the subscription to end once the link expired, got %+v", j)
+	}
+}
+
+func TestUpdateJourneyPosition(t *testing.T) {
+	now := time.Now().UTC()
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	tests := []struct {
+		name     string
+		statuses []model.JourneyStatus
+		position *model.NewPosition
+		code     string
+		err      error
+	}{
+		{
+			name:     "later than current",
+			position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: at(time.Second)},
+		},
+		{
+			name:     "paused",
+			statuses: []model.JourneyStatus{model.JourneyStatusPaused},
+			position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: at(time.Second)},
+			err:      ErrBadRequest,
+		},
+		{
+			name:     "completed",
+			statuses: []model.JourneyStatus{model.JourneyStatusComplete},
+			position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: at(time.Second)},
+			err:      ErrBadRequest,
+		},
+		{
+			name:     "resumed and older than current",
+			statuses: []model.JourneyStatus{model.JourneyStatusPaused, model.JourneyStatusActive},
+			position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: at(-time.Second)},
+			code:     string(validation.CodeOutOfOrder),
+		},
+		{
+			name:     "older than current",
+			position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: at(-time.Second)},
+			code:     string(validation.CodeOutOfOrder),
+		},
+		{
+			name:     "in the future",
+			position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: at(time.Hour)},
+			code:     string(validation.CodeFutureTimestamp),
+		},
+		{
+			name:     "out of bounds",
+			position: &model.NewPosition{Lat: 91, Lng: -0.12, RecordedAt: at(time.Second)},
+			code:     string(validation.CodeInvalidCoordinates),
+		},
+		{
+			name:     "too fast",
+			position: &model.NewPosition{Lat: 51.6, Lng: -0.12, RecordedAt: at(time.Second)},
+			code:     string(validation.CodeImplausibleSpeed),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newResolver()
+			journey := createJourney(t, r)
+
+			if _, err := r.Mutation().UpdateJourneyPosition(withSubject(owner), model.UpdateJourneyPosition{
+				ID:       journey.ID,
+				Position: &model.NewPosition{Lat: 51.5, Lng: -0.12, RecordedAt: &now},
+			}); err != nil {
+				t.Fatalf("unable to set first position: %v", err)
+			}
+			setStatus(t, r, journey.ID, tt.statuses...)
+
+			got, err := r.Mutation().UpdateJourneyPosition(withSubject(owner),
+				model.UpdateJourneyPosition{ID: journey.ID, Position: tt.position})
+			switch {
+			case tt.code != "":
+				if code(err) != tt.code {
+					t.Fatalf("expected code %s, got %v", tt.code, err)
+				}
+			case tt.err != nil:
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected %v, got %v", tt.err, err)
+				}
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case !got.Position.RecordedAt.Equal(*tt.position.RecordedAt):
+				t.Errorf("expected position recorded at %s, got %s", tt.position.RecordedAt, got.Position.RecordedAt)
+			}
+		})
+	}
+}
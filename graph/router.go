@@ -20,7 +20,12 @@ import (
 	"time"
 )
 
-func NewRouter(e *echo.Echo, srv *handler.Server) *echo.Echo {
+const (
+	maxUploadSize   = 10 << 20
+	maxUploadMemory = 2 << 20
+)
+
+func NewRouter(e *echo.Echo, srv *handler.Server, resolver *Resolver) *echo.Echo {
 	origin := os.Getenv("ORIGIN")
 	issuerURL, err := url.Parse(fmt.Sprintf("https://%s/", os.Getenv("AUTH0_DOMAIN")))
 	if err != nil {
@@ -39,6 +44,10 @@ func NewRouter(e *echo.Echo, srv *handler.Server) *echo.Echo {
 	}
 
 	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{
+		MaxUploadSize: maxUploadSize,
+		MaxMemory:     maxUploadMemory,
+	})
 	srv.AddTransport(&transport.Websocket{
 		Upgrader: websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool {
@@ -56,8 +65,14 @@ func NewRouter(e *echo.Echo, srv *handler.Server) *echo.Echo {
 		KeepAlivePingInterval: 10 * time.Second,
 		PingPongInterval:      time.Second,
 		InitFunc: func(ctx context.Context, p transport.InitPayload) (context.Context, error) {
-			token, err := jwtValidator.ValidateToken(ctx, strings.TrimPrefix(p.Authorization(), "Bearer "))
+			authorization := strings.TrimPrefix(p.Authorization(), "Bearer ")
+			token, err := jwtValidator.ValidateToken(ctx, authorization)
 			if err != nil {
+				// connections without an account may follow a single journey with a share link token instead
+				if linkID, linkErr := resolver.signer.Verify(authorization); linkErr == nil {
+					return context.WithValue(ctx, shareLinkContextKey{}, linkID), nil
+				}
+
 				log.Warn().Err(err).Msg("unable to initialise websocket connection")
 				return nil, ErrUnAuthorized
 			}
@@ -86,15 +101,19 @@ func NewRouter(e *echo.Echo, srv *handler.Server) *echo.Echo {
 		AllowOrigins: []string{origin},
 	}))
 
+	checkJWT := echo.WrapMiddleware(jwtmiddleware.New(jwtValidator.ValidateToken).CheckJWT)
+
 	e.POST("/query", func(c echo.Context) error {
 		srv.ServeHTTP(c.Response(), c.Request())
 		return nil
-	}, echo.WrapMiddleware(jwtmiddleware.New(jwtValidator.ValidateToken).CheckJWT))
+	}, checkJWT, withBaseURL(os.Getenv("PUBLIC_URL")))
+
+	e.GET(exportPath(":id"), resolver.export, checkJWT)
 
 	e.GET("/subscriptions", func(c echo.Context) error {
 		srv.ServeHTTP(c.Response(), c.Request())
 		return nil
-	})
+	}, withBaseURL(os.Getenv("PUBLIC_URL")))
 
 	return e
 }
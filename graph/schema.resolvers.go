@@ -5,14 +5,24 @@ package graph
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
 
-	"github.com/ably/ably-go/ably"
+	"github.com/99designs/gqlgen/graphql"
 	jwtmiddleware "github.com/auth0/go-jwt-middleware/v2"
 	"github.com/auth0/go-jwt-middleware/v2/validator"
+	"github.com/cobbinma/track-api/formats"
+	"github.com/cobbinma/track-api/geo"
+	"github.com/cobbinma/track-api/geofences"
 	"github.com/cobbinma/track-api/graph/generated"
 	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/lifecycle"
+	"github.com/cobbinma/track-api/repositories"
+	"github.com/cobbinma/track-api/validation"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 )
@@ -21,9 +31,174 @@ var (
 	ErrUnAuthorized = fmt.Errorf("unauthorized")
 	ErrUnexpected   = fmt.Errorf("unexpected error")
 	ErrBadRequest   = fmt.Errorf("bad request")
+	ErrNotFound     = fmt.Errorf("not found")
 )
 
-func (r *mutationResolver) CreateJourney(ctx context.Context) (*model.Journey, error) {
+func (r *journeyResolver) Track(ctx context.Context, obj *model.Journey, first *int, after *string, since *time.Time) (*model.TrackConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid page size")
+		return nil, err
+	}
+
+	key, err := decodeCursor(after)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid cursor")
+		return nil, err
+	}
+
+	var afterSeq *int
+	if key != nil {
+		seq, err := strconv.Atoi(*key)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid cursor")
+			return nil, ErrBadRequest
+		}
+		afterSeq = &seq
+	}
+
+	points, err := r.repository.ListPositions(ctx, obj.ID, since, afterSeq, uint64(size+1))
+	if err != nil {
+		log.Error().Err(err).Str("journeyId", obj.ID).Msg("unable to list positions from repository")
+		return nil, ErrUnexpected
+	}
+
+	connection := &model.TrackConnection{
+		Edges:    []*model.TrackEdge{},
+		PageInfo: &model.PageInfo{HasNextPage: len(points) > size},
+	}
+	if connection.PageInfo.HasNextPage {
+		points = points[:size]
+	}
+
+	for _, point := range points {
+		connection.Edges = append(connection.Edges, &model.TrackEdge{
+			Cursor: encodeCursor(strconv.Itoa(point.Seq)),
+			Node:   point,
+		})
+	}
+	if n := len(connection.Edges); n > 0 {
+		connection.PageInfo.EndCursor = &connection.Edges[n-1].Cursor
+	}
+
+	return connection, nil
+}
+
+func (r *journeyResolver) Path(ctx context.Context, obj *model.Journey, tolerance *float64, encoding *model.PathEncoding) (*model.Path, error) {
+	t := 0.0
+	if tolerance != nil {
+		t = *tolerance
+	}
+	if math.IsNaN(t) || t < 0 {
+		log.Warn().Float64("tolerance", t).Msg("invalid path tolerance")
+		return nil, ErrBadRequest
+	}
+
+	points, err := r.simplifiedPath(ctx, obj, t)
+	if err != nil {
+		log.Error().Err(err).Str("journeyId", obj.ID).Msg("unable to simplify journey path")
+		return nil, ErrUnexpected
+	}
+
+	path := &model.Path{
+		Encoding:   model.PathEncodingCoordinates,
+		PointCount: len(points),
+	}
+	if encoding != nil {
+		path.Encoding = *encoding
+	}
+
+	switch path.Encoding {
+	case model.PathEncodingPolyline:
+		polyline := geo.EncodePolyline(points)
+		path.Polyline = &polyline
+	default:
+		path.Coordinates = make([]*model.Coordinates, 0, len(points))
+		for _, p := range points {
+			path.Coordinates = append(path.Coordinates, &model.Coordinates{Lat: p.Lat, Lng: p.Lng})
+		}
+	}
+
+	return path, nil
+}
+
+func (r *journeyResolver) GpxURL(ctx context.Context, obj *model.Journey) (string, error) {
+	return r.ExportURL(ctx, obj, model.ExportFormatGpx)
+}
+
+func (r *journeyResolver) ExportURL(ctx context.Context, obj *model.Journey, format model.ExportFormat) (string, error) {
+	f, err := formats.ParseFormat(format.String())
+	if err != nil {
+		log.Error().Err(err).Msg("unsupported export format")
+		return "", ErrUnexpected
+	}
+
+	return exportURL(ctx, obj.ID, f), nil
+}
+
+func (r *journeyResolver) Events(ctx context.Context, obj *model.Journey, first *int, after *string) (*model.JourneyLogConnection, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	if user := claims.RegisteredClaims.Subject; obj.User.ID != user {
+		log.Warn().Str("subject", user).Str("journeyId", obj.ID).
+			Msg("unauthorized subject attempting to view journey events")
+		return nil, ErrUnAuthorized
+	}
+
+	size, err := pageSize(first)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid page size")
+		return nil, err
+	}
+
+	key, err := decodeCursor(after)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid cursor")
+		return nil, err
+	}
+
+	var afterSeq *int64
+	if key != nil {
+		seq, err := strconv.ParseInt(*key, 10, 64)
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid cursor")
+			return nil, ErrBadRequest
+		}
+		afterSeq = &seq
+	}
+
+	events, err := r.repository.ListJourneyLogEvents(ctx, obj.ID, afterSeq, uint64(size+1))
+	if err != nil {
+		log.Error().Err(err).Str("journeyId", obj.ID).Msg("unable to list journey events from repository")
+		return nil, ErrUnexpected
+	}
+
+	connection := &model.JourneyLogConnection{
+		Edges:    []*model.JourneyLogEdge{},
+		PageInfo: &model.PageInfo{HasNextPage: len(events) > size},
+	}
+	if connection.PageInfo.HasNextPage {
+		events = events[:size]
+	}
+
+	for _, event := range events {
+		connection.Edges = append(connection.Edges, &model.JourneyLogEdge{
+			Cursor: encodeCursor(strconv.FormatInt(event.Seq, 10)),
+			Node:   event,
+		})
+	}
+	if n := len(connection.Edges); n > 0 {
+		connection.PageInfo.EndCursor = &connection.Edges[n-1].Cursor
+	}
+
+	return connection, nil
+}
+
+func (r *mutationResolver) CreateJourney(ctx context.Context, destination *model.NewCoordinates) (*model.Journey, error) {
 	id := uuid.New()
 	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
 	if !ok {
@@ -31,13 +206,38 @@ func (r *mutationResolver) CreateJourney(ctx context.Context) (*model.Journey, e
 		return nil, ErrUnAuthorized
 	}
 
+	if destination != nil {
+		if err := validation.Bounds(nil, &model.Position{Lat: destination.Lat, Lng: destination.Lng}); err != nil {
+			log.Warn().Err(err).Msg("rejected destination")
+			return nil, rejectedPosition(err)
+		}
+	}
+
+	now := time.Now().UTC()
 	journey := &model.Journey{
-		ID:     id.String(),
-		User:   &model.User{ID: claims.RegisteredClaims.Subject},
-		Status: model.JourneyStatusActive,
+		ID:        id.String(),
+		User:      &model.User{ID: claims.RegisteredClaims.Subject},
+		Status:    model.JourneyStatusActive,
+		Version:   1,
+		Stats:     &model.JourneyStats{},
+		CreatedAt: now,
+		UpdatedAt: now,
 	}
+	if destination != nil {
+		journey.Destination = &model.Coordinates{Lat: destination.Lat, Lng: destination.Lng}
+	}
+
+	if err := r.repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := r.repository.CreateJourney(ctx, journey); err != nil {
+			return fmt.Errorf("create journey : %w", err)
+		}
 
-	if err := r.repository.CreateJourney(ctx, journey); err != nil {
+		return r.logEvent(ctx, &model.JourneyLogEvent{
+			JourneyID: journey.ID,
+			Action:    model.JourneyLogActionCreated,
+			Status:    &journey.Status,
+		})
+	}); err != nil {
 		log.Error().Err(err).Msg("unable to create journey in repository")
 		return nil, ErrUnexpected
 	}
@@ -64,46 +264,29 @@ func (r *mutationResolver) UpdateJourneyStatus(ctx context.Context, input model.
 		return nil, ErrUnAuthorized
 	}
 
-	switch {
-	case journey.Status == input.Status:
-		break
-	case journey.Status == model.JourneyStatusComplete:
-		{
-			log.Warn().Str("journeyId", input.ID).Msg("journey is already complete")
-			break
+	// the journey is read again under lock so the transition is checked against its current status
+	if err := r.repository.Transaction(ctx, func(ctx context.Context) error {
+		locked, err := r.repository.GetJourneyForUpdate(ctx, journey.ID)
+		if err != nil {
+			return fmt.Errorf("get journey for update : %w", err)
 		}
-	case journey.Status == model.JourneyStatusActive, input.Status == model.JourneyStatusComplete:
-		{
-			journey.Status = input.Status
-			journey.Position = nil
-
-			if err := r.repository.UpdatePosition(ctx, journey.ID, journey.Position); err != nil {
-				log.Error().Err(err).Msg("unable to update position in repository")
-				return nil, ErrUnexpected
-			}
-
-			if err := r.repository.UpdateStatus(ctx, journey.ID, journey.Status); err != nil {
-				log.Error().Err(err).Msg("unable to update status in repository")
-				return nil, ErrUnexpected
-			}
+		journey = locked
 
-			message, err := json.Marshal(journey)
-			if err != nil {
-				log.Error().Err(err).Msg("unable to marshal message")
-				return nil, ErrUnexpected
-			}
+		if journey.Status == input.Status {
+			return nil
+		}
 
-			if err := r.queue.Channels.Get(input.ID).
-				Publish(ctx, "JourneyUpdate", string(message)); err != nil {
-				log.Error().Err(err).Str("journeyId", input.ID).Msg("unable to publish message in queue")
-				return nil, ErrUnexpected
-			}
+		return r.updateStatus(ctx, journey, input.Status, model.JourneyEventStatusChanged)
+	}); err != nil {
+		var tErr *lifecycle.TransitionError
+		if errors.As(err, &tErr) {
+			log.Warn().Err(err).Str("journeyId", input.ID).Msg("rejected journey status transition")
+			return nil, rejectedTransition(tErr)
 		}
-	default:
-		log.Error().Str("current_status", journey.Status.String()).
-			Str("input_status", input.Status.String()).Msg("unrecognised condition")
+		log.Error().Err(err).Str("journeyId", input.ID).Msg("unable to update journey status")
 		return nil, ErrUnexpected
 	}
+	r.relay.Wake()
 
 	return journey, nil
 }
@@ -124,45 +307,258 @@ func (r *mutationResolver) UpdateJourneyPosition(ctx context.Context, input mode
 	if user := claims.RegisteredClaims.Subject; journey.User.ID != user {
 		log.Warn().Str("subject", user).Str("journeyId", journey.ID).
 			Msg("unauthorized subject attempting to update journey")
+		return nil, ErrUnAuthorized
 	}
 
-	if status := journey.Status; status != model.JourneyStatusActive {
+	if status := journey.Status; !lifecycle.Tracking(status) {
 		log.Warn().Str("journeyId", journey.ID).Str("status", status.String()).
 			Msg("unsupported update position status")
 		return nil, ErrBadRequest
 	}
 
-	journey.Position = &model.Position{
-		Lat: input.Position.Lat,
-		Lng: input.Position.Lng,
-	}
+	now := time.Now().UTC()
+	position := newPosition(input.Position, now)
+
+	if err := r.repository.Transaction(ctx, func(ctx context.Context) error {
+		locked, err := r.lockTrackedJourney(ctx, journey.ID)
+		if err != nil {
+			return err
+		}
+		journey = locked
+
+		if err := r.validator.Validate(journey.Position, position); err != nil {
+			return err
+		}
+		journey.Stats = addStats(journey, trackPoint(position))
+		previous := journey.Position
+		journey.Position = position
+		journey.Event = &positionUpdated
+		journey.UpdatedAt = now
+
+		if err := r.repository.AddPosition(ctx, journey.ID, journey.Position); err != nil {
+			return fmt.Errorf("add position : %w", err)
+		}
+
+		if err := r.logEvent(ctx, &model.JourneyLogEvent{
+			JourneyID: journey.ID,
+			Action:    model.JourneyLogActionPositionUpdated,
+			Position:  journey.Position,
+		}); err != nil {
+			return err
+		}
+
+		if err := r.repository.UpdateStats(ctx, journey.ID, journey.Stats); err != nil {
+			return fmt.Errorf("update stats : %w", err)
+		}
+
+		if err := r.estimateArrival(ctx, journey); err != nil {
+			return fmt.Errorf("estimate arrival : %w", err)
+		}
+
+		version, err := r.repository.IncrementVersion(ctx, journey.ID)
+		if err != nil {
+			return fmt.Errorf("increment version : %w", err)
+		}
+		journey.Version = version
+
+		if err := r.repository.AddOutboxEvent(ctx, journey); err != nil {
+			return fmt.Errorf("add outbox event : %w", err)
+		}
+
+		if err := r.evaluateGeofences(ctx, journey, previous, position); err != nil {
+			return fmt.Errorf("evaluate geofences : %w", err)
+		}
+
+		if err := r.detectArrival(ctx, journey); err != nil {
+			return fmt.Errorf("detect arrival : %w", err)
+		}
 
-	if err := r.repository.UpdatePosition(ctx, journey.ID, journey.Position); err != nil {
-		log.Error().Err(err).Msg("unable to update position in repository")
+		return nil
+	}); err != nil {
+		var vErr *validation.Error
+		switch {
+		case errors.As(err, &vErr):
+			log.Warn().Err(err).Str("journeyId", input.ID).Msg("rejected position")
+			return nil, rejectedPosition(err)
+		case errors.Is(err, errNotTracking):
+			log.Warn().Str("journeyId", input.ID).Msg("journey stopped tracking before position was recorded")
+			return nil, ErrBadRequest
+		}
+		log.Error().Err(err).Str("journeyId", input.ID).Msg("unable to update journey position")
 		return nil, ErrUnexpected
 	}
+	r.relay.Wake()
+
+	return journey, nil
+}
+
+func (r *mutationResolver) AppendJourneyPositions(ctx context.Context, id string, positions []*model.NewPosition) (*model.Journey, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	if len(positions) == 0 || len(positions) > maxAppendPositions {
+		log.Warn().Str("journeyId", id).Int("positions", len(positions)).Msg("unsupported number of positions")
+		return nil, ErrBadRequest
+	}
 
-	message, err := json.Marshal(journey)
+	journey, err := r.repository.GetJourney(ctx, id)
 	if err != nil {
-		log.Error().Err(err).Msg("unable to marshal message")
+		log.Error().Err(err).Msg("unable to get journey from repository")
 		return nil, ErrUnexpected
 	}
 
-	if err := r.queue.Channels.Get(input.ID).Publish(ctx, "JourneyUpdate", string(message)); err != nil {
-		log.Error().Err(err).Str("journeyId", input.ID).Msg("unable to publish message in queue")
+	if user := claims.RegisteredClaims.Subject; journey.User.ID != user {
+		log.Warn().Str("subject", user).Str("journeyId", journey.ID).
+			Msg("unauthorized subject attempting to update journey")
+		return nil, ErrUnAuthorized
+	}
+
+	if status := journey.Status; !lifecycle.Tracking(status) {
+		log.Warn().Str("journeyId", journey.ID).Str("status", status.String()).
+			Msg("unsupported update position status")
+		return nil, ErrBadRequest
+	}
+
+	now := time.Now().UTC()
+	ps := make([]*model.Position, 0, len(positions))
+	for _, p := range positions {
+		ps = append(ps, newPosition(p, now))
+	}
+	sort.SliceStable(ps, func(i, j int) bool { return ps[i].RecordedAt.Before(*ps[j].RecordedAt) })
+
+	points := make([]*model.TrackPoint, 0, len(ps))
+	for _, p := range ps {
+		points = append(points, trackPoint(p))
+	}
+
+	if err := r.repository.Transaction(ctx, func(ctx context.Context) error {
+		locked, err := r.lockTrackedJourney(ctx, journey.ID)
+		if err != nil {
+			return err
+		}
+		journey = locked
+
+		if err := r.validator.Validate(journey.Position, ps...); err != nil {
+			return err
+		}
+		journey.Stats = addStats(journey, points...)
+		previous := journey.Position
+		journey.Position = ps[len(ps)-1]
+		journey.Event = &positionUpdated
+		journey.UpdatedAt = now
+
+		if err := r.repository.AddPositions(ctx, journey.ID, points); err != nil {
+			return fmt.Errorf("add positions : %w", err)
+		}
+
+		if err := r.repository.UpdatePosition(ctx, journey.ID, journey.Position); err != nil {
+			return fmt.Errorf("update position : %w", err)
+		}
+
+		if err := r.logEvent(ctx, &model.JourneyLogEvent{
+			JourneyID: journey.ID,
+			Action:    model.JourneyLogActionPositionUpdated,
+			Position:  journey.Position,
+		}); err != nil {
+			return err
+		}
+
+		if err := r.repository.UpdateStats(ctx, journey.ID, journey.Stats); err != nil {
+			return fmt.Errorf("update stats : %w", err)
+		}
+
+		if err := r.estimateArrival(ctx, journey); err != nil {
+			return fmt.Errorf("estimate arrival : %w", err)
+		}
+
+		version, err := r.repository.IncrementVersion(ctx, journey.ID)
+		if err != nil {
+			return fmt.Errorf("increment version : %w", err)
+		}
+		journey.Version = version
+
+		if err := r.repository.AddOutboxEvent(ctx, journey); err != nil {
+			return fmt.Errorf("add outbox event : %w", err)
+		}
+
+		if err := r.evaluateGeofences(ctx, journey, previous, ps...); err != nil {
+			return fmt.Errorf("evaluate geofences : %w", err)
+		}
+
+		if err := r.detectArrival(ctx, journey); err != nil {
+			return fmt.Errorf("detect arrival : %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		var vErr *validation.Error
+		switch {
+		case errors.As(err, &vErr):
+			log.Warn().Err(err).Str("journeyId", id).Msg("rejected positions")
+			return nil, rejectedPosition(err)
+		case errors.Is(err, errNotTracking):
+			log.Warn().Str("journeyId", id).Msg("journey stopped tracking before positions were recorded")
+			return nil, ErrBadRequest
+		}
+		log.Error().Err(err).Str("journeyId", id).Msg("unable to append journey positions")
 		return nil, ErrUnexpected
 	}
+	r.relay.Wake()
 
 	return journey, nil
 }
 
-func (r *subscriptionResolver) Journey(ctx context.Context, id string) (<-chan *model.Journey, error) {
-	if _, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims); !ok {
+func (r *mutationResolver) ShareJourney(ctx context.Context, id string, userID string) (*model.Journey, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
 		log.Warn().Msg("no claims in context")
 		return nil, ErrUnAuthorized
 	}
 
-	ch := make(chan *model.Journey, 1)
+	journey, err := r.repository.GetJourney(ctx, id)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get journey from repository")
+		return nil, ErrUnexpected
+	}
+
+	if user := claims.RegisteredClaims.Subject; journey.User.ID != user {
+		log.Warn().Str("subject", user).Str("journeyId", journey.ID).
+			Msg("unauthorized subject attempting to share journey")
+		return nil, ErrUnAuthorized
+	}
+
+	if userID == journey.User.ID {
+		log.Warn().Str("journeyId", journey.ID).Msg("journey cannot be shared with its owner")
+		return nil, ErrBadRequest
+	}
+
+	if err := r.repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := r.repository.AddViewer(ctx, journey.ID, userID); err != nil {
+			return fmt.Errorf("add viewer : %w", err)
+		}
+
+		return r.logEvent(ctx, &model.JourneyLogEvent{
+			JourneyID: journey.ID,
+			Action:    model.JourneyLogActionShared,
+			Viewer:    &model.User{ID: userID},
+		})
+	}); err != nil {
+		log.Error().Err(err).Str("journeyId", journey.ID).Msg("unable to add viewer in repository")
+		return nil, ErrUnexpected
+	}
+
+	return journey, nil
+}
+
+func (r *mutationResolver) RevokeJourneyShare(ctx context.Context, id string, userID string) (*model.Journey, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
 
 	journey, err := r.repository.GetJourney(ctx, id)
 	if err != nil {
@@ -170,38 +566,576 @@ func (r *subscriptionResolver) Journey(ctx context.Context, id string) (<-chan *
 		return nil, ErrUnexpected
 	}
 
-	ch <- journey
+	if user := claims.RegisteredClaims.Subject; journey.User.ID != user {
+		log.Warn().Str("subject", user).Str("journeyId", journey.ID).
+			Msg("unauthorized subject attempting to revoke journey share")
+		return nil, ErrUnAuthorized
+	}
+
+	if err := r.repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := r.repository.RemoveViewer(ctx, journey.ID, userID); err != nil {
+			return fmt.Errorf("remove viewer : %w", err)
+		}
 
-	go func(ch chan<- *model.Journey) {
-		unsubscribe, err := r.queue.Channels.Get(id).SubscribeAll(ctx, func(msg *ably.Message) {
-			if data, ok := msg.Data.(string); ok {
-				var j = &model.Journey{}
-				if err := json.Unmarshal([]byte(data), j); err != nil {
-					log.Error().Err(err).Msg("unable to unmarshal message")
-					return
-				}
-				ch <- j
-				return
-			}
-			log.Error().Err(err).Msgf("unsupported message type: %T", msg.Data)
+		return r.logEvent(ctx, &model.JourneyLogEvent{
+			JourneyID: journey.ID,
+			Action:    model.JourneyLogActionShareRevoked,
+			Viewer:    &model.User{ID: userID},
 		})
-		if err != nil {
-			log.Error().Err(err).Msgf("unable to subscribe")
-			return
+	}); err != nil {
+		log.Error().Err(err).Str("journeyId", journey.ID).Msg("unable to remove viewer in repository")
+		return nil, ErrUnexpected
+	}
+
+	return journey, nil
+}
+
+func (r *mutationResolver) CreateShareLink(ctx context.Context, journeyID string, expiresAt time.Time) (*model.ShareLink, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	journey, err := r.repository.GetJourney(ctx, journeyID)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get journey from repository")
+		return nil, ErrUnexpected
+	}
+
+	if user := claims.RegisteredClaims.Subject; journey.User.ID != user {
+		log.Warn().Str("subject", user).Str("journeyId", journey.ID).
+			Msg("unauthorized subject attempting to create share link")
+		return nil, ErrUnAuthorized
+	}
+
+	if !expiresAt.After(time.Now()) {
+		log.Warn().Str("journeyId", journey.ID).Time("expiresAt", expiresAt).Msg("share link expiry is in the past")
+		return nil, ErrBadRequest
+	}
+
+	link := &model.ShareLink{
+		ID:        uuid.New().String(),
+		JourneyID: journey.ID,
+		ExpiresAt: expiresAt,
+	}
+
+	link.Token, err = r.signer.Sign(link.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to sign share link")
+		return nil, ErrUnexpected
+	}
+
+	if err := r.repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := r.repository.CreateShareLink(ctx, link); err != nil {
+			return fmt.Errorf("create share link : %w", err)
 		}
 
-		<-ctx.Done()
-		unsubscribe()
-	}(ch)
+		return r.logEvent(ctx, &model.JourneyLogEvent{
+			JourneyID:   journey.ID,
+			Action:      model.JourneyLogActionShareLinkCreated,
+			ShareLinkID: &link.ID,
+		})
+	}); err != nil {
+		log.Error().Err(err).Str("journeyId", journey.ID).Msg("unable to create share link in repository")
+		return nil, ErrUnexpected
+	}
 
-	return ch, nil
+	return link, nil
 }
 
-// Mutation returns generated.MutationResolver implementation.
-func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+func (r *mutationResolver) RevokeShareLink(ctx context.Context, id string) (*model.ShareLink, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	link, err := r.repository.GetShareLink(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		log.Error().Err(err).Msg("unable to get share link from repository")
+		return nil, ErrUnexpected
+	}
+
+	journey, err := r.repository.GetJourney(ctx, link.JourneyID)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to get journey from repository")
+		return nil, ErrUnexpected
+	}
+
+	if user := claims.RegisteredClaims.Subject; journey.User.ID != user {
+		log.Warn().Str("subject", user).Str("journeyId", journey.ID).
+			Msg("unauthorized subject attempting to revoke share link")
+		return nil, ErrUnAuthorized
+	}
+
+	if err := r.repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := r.repository.RevokeShareLink(ctx, link.ID); err != nil {
+			return fmt.Errorf("revoke share link : %w", err)
+		}
+
+		return r.logEvent(ctx, &model.JourneyLogEvent{
+			JourneyID:   journey.ID,
+			Action:      model.JourneyLogActionShareLinkRevoked,
+			ShareLinkID: &link.ID,
+		})
+	}); err != nil {
+		log.Error().Err(err).Str("shareLinkId", link.ID).Msg("unable to revoke share link in repository")
+		return nil, ErrUnexpected
+	}
+	link.Revoked = true
+
+	link.Token, err = r.signer.Sign(link.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to sign share link")
+		return nil, ErrUnexpected
+	}
+
+	return link, nil
+}
+
+func (r *mutationResolver) ImportJourney(ctx context.Context, file graphql.Upload, format *model.ImportFormat) (*model.Journey, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	var (
+		f   formats.Format
+		err error
+	)
+	if format != nil {
+		f, err = formats.ParseImportFormat(format.String())
+	} else {
+		f, err = formats.FormatFromFilename(file.Filename)
+	}
+	if err != nil {
+		log.Warn().Err(err).Str("filename", file.Filename).Msg("unsupported import format")
+		return nil, ErrBadRequest
+	}
+
+	points, err := f.Decode(file.File)
+	if err != nil {
+		log.Warn().Err(err).Str("filename", file.Filename).Msg("unable to decode imported journey")
+		return nil, ErrBadRequest
+	}
+
+	now := time.Now().UTC()
+	journey := &model.Journey{
+		ID:          uuid.New().String(),
+		User:        &model.User{ID: claims.RegisteredClaims.Subject},
+		Status:      model.JourneyStatusComplete,
+		Version:     1,
+		CreatedAt:   now,
+		CompletedAt: &now,
+		UpdatedAt:   now,
+	}
+	journey.Stats = addStats(journey, points...)
+
+	if err := r.repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := r.repository.CreateJourney(ctx, journey); err != nil {
+			return fmt.Errorf("create journey : %w", err)
+		}
+
+		if err := r.repository.AddPositions(ctx, journey.ID, points); err != nil {
+			return fmt.Errorf("add positions : %w", err)
+		}
+
+		return r.logEvent(ctx, &model.JourneyLogEvent{
+			JourneyID: journey.ID,
+			Action:    model.JourneyLogActionImported,
+			Status:    &journey.Status,
+		})
+	}); err != nil {
+		log.Error().Err(err).Msg("unable to import journey in repository")
+		return nil, ErrUnexpected
+	}
+
+	return journey, nil
+}
+
+func (r *mutationResolver) CreateGeofence(ctx context.Context, input model.NewGeofence) (*model.Geofence, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	fence := newGeofence(uuid.New().String(), claims.RegisteredClaims.Subject, input)
+	if err := geofences.Validate(fence); err != nil {
+		log.Warn().Err(err).Msg("invalid geofence")
+		return nil, ErrBadRequest
+	}
+
+	if err := r.repository.CreateGeofence(ctx, fence); err != nil {
+		log.Error().Err(err).Msg("unable to create geofence in repository")
+		return nil, ErrUnexpected
+	}
+
+	return fence, nil
+}
+
+func (r *mutationResolver) UpdateGeofence(ctx context.Context, id string, input model.NewGeofence) (*model.Geofence, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	existing, err := r.repository.GetGeofence(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		log.Error().Err(err).Msg("unable to get geofence from repository")
+		return nil, ErrUnexpected
+	}
+
+	if user := claims.RegisteredClaims.Subject; existing.User.ID != user {
+		log.Warn().Str("subject", user).Str("geofenceId", existing.ID).
+			Msg("unauthorized subject attempting to update geofence")
+		return nil, ErrUnAuthorized
+	}
+
+	fence := newGeofence(existing.ID, existing.User.ID, input)
+	if err := geofences.Validate(fence); err != nil {
+		log.Warn().Err(err).Msg("invalid geofence")
+		return nil, ErrBadRequest
+	}
+
+	if err := r.repository.UpdateGeofence(ctx, fence); err != nil {
+		log.Error().Err(err).Str("geofenceId", fence.ID).Msg("unable to update geofence in repository")
+		return nil, ErrUnexpected
+	}
+
+	return fence, nil
+}
+
+func (r *mutationResolver) DeleteGeofence(ctx context.Context, id string) (*model.Geofence, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	fence, err := r.repository.GetGeofence(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		log.Error().Err(err).Msg("unable to get geofence from repository")
+		return nil, ErrUnexpected
+	}
+
+	if user := claims.RegisteredClaims.Subject; fence.User.ID != user {
+		log.Warn().Str("subject", user).Str("geofenceId", fence.ID).
+			Msg("unauthorized subject attempting to delete geofence")
+		return nil, ErrUnAuthorized
+	}
+
+	if err := r.repository.DeleteGeofence(ctx, fence.ID); err != nil {
+		log.Error().Err(err).Str("geofenceId", fence.ID).Msg("unable to delete geofence in repository")
+		return nil, ErrUnexpected
+	}
+
+	return fence, nil
+}
+
+func (r *queryResolver) Journey(ctx context.Context, id string) (*model.Journey, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	journey, err := r.repository.GetJourney(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		log.Error().Err(err).Msg("unable to get journey from repository")
+		return nil, ErrUnexpected
+	}
+
+	if err := r.authorizeViewer(ctx, journey, claims.RegisteredClaims.Subject); err != nil {
+		if errors.Is(err, ErrUnAuthorized) {
+			log.Warn().Str("subject", claims.RegisteredClaims.Subject).Str("journeyId", journey.ID).
+				Msg("unauthorized subject attempting to view journey")
+			return nil, ErrUnAuthorized
+		}
+		log.Error().Err(err).Str("journeyId", journey.ID).Msg("unable to authorize viewer")
+		return nil, ErrUnexpected
+	}
+
+	return journey, nil
+}
+
+func (r *queryResolver) MyJourneys(ctx context.Context, status *model.JourneyStatus, first *int, after *string) (*model.JourneyConnection, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	size, err := pageSize(first)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid page size")
+		return nil, err
+	}
+
+	cursor, err := decodeJourneyCursor(after)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid cursor")
+		return nil, err
+	}
+
+	journeys, err := r.repository.ListJourneys(ctx, claims.RegisteredClaims.Subject, status, cursor, uint64(size+1))
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list journeys from repository")
+		return nil, ErrUnexpected
+	}
+
+	connection := &model.JourneyConnection{
+		Edges:    []*model.JourneyEdge{},
+		PageInfo: &model.PageInfo{HasNextPage: len(journeys) > size},
+	}
+	if connection.PageInfo.HasNextPage {
+		journeys = journeys[:size]
+	}
+
+	for _, journey := range journeys {
+		connection.Edges = append(connection.Edges, &model.JourneyEdge{
+			Cursor: encodeJourneyCursor(journey),
+			Node:   journey,
+		})
+	}
+	if n := len(connection.Edges); n > 0 {
+		connection.PageInfo.EndCursor = &connection.Edges[n-1].Cursor
+	}
+
+	return connection, nil
+}
+
+func (r *queryResolver) ActiveJourney(ctx context.Context) (*model.Journey, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	journey, err := r.repository.GetActiveJourney(ctx, claims.RegisteredClaims.Subject)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, nil
+		}
+		log.Error().Err(err).Msg("unable to get active journey from repository")
+		return nil, ErrUnexpected
+	}
+
+	return journey, nil
+}
+
+func (r *queryResolver) Geofences(ctx context.Context) ([]*model.Geofence, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	fences, err := r.repository.ListGeofences(ctx, []string{claims.RegisteredClaims.Subject})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to list geofences from repository")
+		return nil, ErrUnexpected
+	}
+
+	return fences, nil
+}
+
+func (r *queryResolver) Geofence(ctx context.Context, id string) (*model.Geofence, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	fence, err := r.repository.GetGeofence(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		log.Error().Err(err).Msg("unable to get geofence from repository")
+		return nil, ErrUnexpected
+	}
+
+	if user := claims.RegisteredClaims.Subject; fence.User.ID != user {
+		log.Warn().Str("subject", user).Str("geofenceId", fence.ID).
+			Msg("unauthorized subject attempting to view geofence")
+		return nil, ErrUnAuthorized
+	}
+
+	return fence, nil
+}
+
+func (r *subscriptionResolver) Journey(ctx context.Context, id string, since *int) (<-chan *model.Journey, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	linkID, shared := ctx.Value(shareLinkContextKey{}).(string)
+	if !ok && !shared {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	journey, err := r.repository.GetJourney(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		log.Error().Err(err).Msg("unable to get journey from repository")
+		return nil, ErrUnexpected
+	}
+
+	var link *model.ShareLink
+	if ok {
+		err = r.authorizeViewer(ctx, journey, claims.RegisteredClaims.Subject)
+	} else {
+		link, err = r.authorizeShareLink(ctx, linkID, journey.ID)
+	}
+	if err != nil {
+		if errors.Is(err, ErrUnAuthorized) {
+			log.Warn().Str("journeyId", journey.ID).Msg("unauthorized attempt to subscribe to journey")
+			return nil, ErrUnAuthorized
+		}
+		log.Error().Err(err).Str("journeyId", journey.ID).Msg("unable to authorize subscription")
+		return nil, ErrUnexpected
+	}
+
+	updates, err := r.broker.Subscribe(ctx, id)
+	if err != nil {
+		log.Error().Err(err).Str("journeyId", id).Msg("unable to subscribe to broker")
+		return nil, ErrUnexpected
+	}
+
+	missed, err := r.replay(ctx, journey, since)
+	if err != nil {
+		if errors.Is(err, errResyncRequired) {
+			log.Info().Str("journeyId", id).Msg("subscriber must resync journey")
+			return nil, resyncRequired(journey)
+		}
+		log.Error().Err(err).Str("journeyId", id).Msg("unable to replay journey versions")
+		return nil, ErrUnexpected
+	}
+
+	ch := make(chan *model.Journey, 1)
+
+	go func(ch chan<- *model.Journey) {
+		defer close(ch)
+
+		// subscriptions made with a share link end when it expires or is revoked
+		var expired <-chan time.Time
+		if link != nil {
+			timer := time.NewTimer(time.Until(link.ExpiresAt))
+			defer timer.Stop()
+			expired = timer.C
+		}
+
+		// the client has every version up to since, anything at or below the last version sent is a duplicate or
+		// arrived out of order
+		last := -1
+		if since != nil {
+			last = *since
+		}
+		send := func(j *model.Journey) bool {
+			if j.Version <= last {
+				return true
+			}
+			select {
+			case ch <- j:
+				last = j.Version
+				return true
+			case <-expired:
+				return false
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for _, j := range missed {
+			if !send(j) {
+				return
+			}
+		}
+
+		for {
+			select {
+			case j, ok := <-updates:
+				if !ok {
+					return
+				}
+
+				if link != nil {
+					if _, err := r.authorizeShareLink(ctx, link.ID, id); err != nil {
+						log.Info().Err(err).Str("shareLinkId", link.ID).Msg("ending share link subscription")
+						return
+					}
+				}
+
+				if !send(j) {
+					return
+				}
+			case <-expired:
+				return
+			}
+		}
+	}(ch)
+
+	return ch, nil
+}
+
+func (r *subscriptionResolver) GeofenceEvents(ctx context.Context) (<-chan model.GeofenceEvent, error) {
+	claims, ok := ctx.Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+	if !ok {
+		log.Warn().Msg("no claims in context")
+		return nil, ErrUnAuthorized
+	}
+
+	crossings, err := r.broker.SubscribeGeofenceCrossings(ctx, claims.RegisteredClaims.Subject)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to subscribe to geofence crossings")
+		return nil, ErrUnexpected
+	}
+
+	ch := make(chan model.GeofenceEvent, 1)
+	go func() {
+		defer close(ch)
+
+		for crossing := range crossings {
+			select {
+			case ch <- r.resolveCrossing(ctx, crossing).Event():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// Journey returns generated.JourneyResolver implementation.
+func (r *Resolver) Journey() generated.JourneyResolver { return &journeyResolver{r} }
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+// Query returns generated.QueryResolver implementation.
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
 // Subscription returns generated.SubscriptionResolver implementation.
 func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
+type journeyResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/lifecycle"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"time"
+)
+
+// updateStatus moves the journey to the status if the transition table allows it, returning a
+// *lifecycle.TransitionError if not. The position is cleared once the journey is final, and kept while it is paused so
+// positions sent after it resumes are validated against the last one. The arrival estimate is cleared unless the
+// journey is still tracked, and the change is recorded for subscribers. The journey should be locked by the caller's
+// transaction, and the caller should wake the relay once the change is committed.
+func (r *Resolver) updateStatus(ctx context.Context, journey *model.Journey, status model.JourneyStatus,
+	event model.JourneyEvent) error {
+	if err := lifecycle.Transition(journey.Status, status); err != nil {
+		return err
+	}
+
+	previous := journey.Status
+	now := time.Now().UTC()
+	journey.Status = status
+	journey.UpdatedAt = now
+	if lifecycle.Final(status) {
+		journey.CompletedAt = &now
+		journey.Position = nil
+	}
+	if !lifecycle.Tracking(status) {
+		journey.RemainingDistance, journey.Eta = nil, nil
+	}
+	journey.Event = &event
+
+	return r.repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := r.repository.UpdatePosition(ctx, journey.ID, journey.Position); err != nil {
+			return fmt.Errorf("update position : %w", err)
+		}
+
+		if err := r.repository.UpdateETA(ctx, journey.ID, journey.RemainingDistance, journey.Eta); err != nil {
+			return fmt.Errorf("update eta : %w", err)
+		}
+
+		if err := r.repository.UpdateStatus(ctx, journey.ID, journey.Status); err != nil {
+			return fmt.Errorf("update status : %w", err)
+		}
+
+		if err := r.logEvent(ctx, &model.JourneyLogEvent{
+			JourneyID:      journey.ID,
+			Action:         model.JourneyLogActionStatusChanged,
+			PreviousStatus: &previous,
+			Status:         &journey.Status,
+			Event:          &event,
+		}); err != nil {
+			return err
+		}
+
+		version, err := r.repository.IncrementVersion(ctx, journey.ID)
+		if err != nil {
+			return fmt.Errorf("increment version : %w", err)
+		}
+		journey.Version = version
+
+		if err := r.repository.AddOutboxEvent(ctx, journey); err != nil {
+			return fmt.Errorf("add outbox event : %w", err)
+		}
+
+		return nil
+	})
+}
+
+// rejectedTransition returns the error describing a status change the transition table does not allow, with a code
+// and the statuses as extensions.
+func rejectedTransition(err *lifecycle.TransitionError) error {
+	return &gqlerror.Error{
+		Message: err.Error(),
+		Extensions: map[string]interface{}{
+			"code": "INVALID_TRANSITION",
+			"from": err.From.String(),
+			"to":   err.To.String(),
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package lifecycle
+
+import (
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+)
+
+// transitions lists the statuses a journey may move to from each status. COMPLETE and CANCELLED are final.
+var transitions = map[model.JourneyStatus][]model.JourneyStatus{
+	model.JourneyStatusActive:    {model.JourneyStatusPaused, model.JourneyStatusComplete, model.JourneyStatusCancelled},
+	model.JourneyStatusPaused:    {model.JourneyStatusActive, model.JourneyStatusComplete, model.JourneyStatusCancelled},
+	model.JourneyStatusComplete:  {},
+	model.JourneyStatusCancelled: {},
+}
+
+// TransitionError is a status change the transition table does not allow.
+type TransitionError struct {
+	From model.JourneyStatus
+	To   model.JourneyStatus
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("journey cannot move from %s to %s", e.From, e.To)
+}
+
+// Transition returns a *TransitionError unless a journey may move from one status to the other.
+func Transition(from, to model.JourneyStatus) error {
+	for _, s := range transitions[from] {
+		if s == to {
+			return nil
+		}
+	}
+
+	return &TransitionError{From: from, To: to}
+}
+
+// Final reports whether a journey in the status can no longer change.
+func Final(status model.JourneyStatus) bool {
+	return len(transitions[status]) == 0
+}
+
+// Tracking reports whether positions are recorded for a journey in the status.
+func Tracking(status model.JourneyStatus) bool {
+	return status == model.JourneyStatusActive
+}
@@ -0,0 +1,89 @@
+package lifecycle
+
+import (
+	"errors"
+	"github.com/cobbinma/track-api/graph/model"
+	"testing"
+)
+
+func TestTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    model.JourneyStatus
+		to      model.JourneyStatus
+		allowed bool
+	}{
+		{name: "pause active", from: model.JourneyStatusActive, to: model.JourneyStatusPaused, allowed: true},
+		{name: "complete active", from: model.JourneyStatusActive, to: model.JourneyStatusComplete, allowed: true},
+		{name: "cancel active", from: model.JourneyStatusActive, to: model.JourneyStatusCancelled, allowed: true},
+		{name: "resume paused", from: model.JourneyStatusPaused, to: model.JourneyStatusActive, allowed: true},
+		{name: "complete paused", from: model.JourneyStatusPaused, to: model.JourneyStatusComplete, allowed: true},
+		{name: "cancel paused", from: model.JourneyStatusPaused, to: model.JourneyStatusCancelled, allowed: true},
+		{name: "active to active", from: model.JourneyStatusActive, to: model.JourneyStatusActive},
+		{name: "resume complete", from: model.JourneyStatusComplete, to: model.JourneyStatusActive},
+		{name: "pause complete", from: model.JourneyStatusComplete, to: model.JourneyStatusPaused},
+		{name: "cancel complete", from: model.JourneyStatusComplete, to: model.JourneyStatusCancelled},
+		{name: "resume cancelled", from: model.JourneyStatusCancelled, to: model.JourneyStatusActive},
+		{name: "complete cancelled", from: model.JourneyStatusCancelled, to: model.JourneyStatusComplete},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Transition(tt.from, tt.to)
+			if tt.allowed {
+				if err != nil {
+					t.Fatalf("expected transition to be allowed, got %v", err)
+				}
+				return
+			}
+
+			var tErr *TransitionError
+			if !errors.As(err, &tErr) {
+				t.Fatalf("expected *TransitionError, got %v", err)
+			}
+			if tErr.From != tt.from || tErr.To != tt.to {
+				t.Errorf("expected transition error from %s to %s, got from %s to %s", tt.from, tt.to, tErr.From, tErr.To)
+			}
+		})
+	}
+}
+
+func TestFinal(t *testing.T) {
+	tests := []struct {
+		status model.JourneyStatus
+		final  bool
+	}{
+		{status: model.JourneyStatusActive, final: false},
+		{status: model.JourneyStatusPaused, final: false},
+		{status: model.JourneyStatusComplete, final: true},
+		{status: model.JourneyStatusCancelled, final: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			if got := Final(tt.status); got != tt.final {
+				t.Errorf("expected %v, got %v", tt.final, got)
+			}
+		})
+	}
+}
+
+func TestTracking(t *testing.T) {
+	tests := []struct {
+		status   model.JourneyStatus
+		tracking bool
+	}{
+		{status: model.JourneyStatusActive, tracking: true},
+		{status: model.JourneyStatusPaused, tracking: false},
+		{status: model.JourneyStatusComplete, tracking: false},
+		{status: model.JourneyStatusCancelled, tracking: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			if got := Tracking(tt.status); got != tt.tracking {
+				t.Errorf("expected %v, got %v", tt.tracking, got)
+			}
+		})
+	}
+}
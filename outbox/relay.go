@@ -0,0 +1,154 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"github.com/cobbinma/track-api/brokers"
+	"github.com/cobbinma/track-api/repositories"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+const (
+	batchSize  = 100
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+	// maxAttempts is how many times an event is published before it is given up on, so a journey's later events are
+	// not held back forever by one that can never be delivered.
+	maxAttempts = 10
+)
+
+// Relay delivers outbox events to the broker. An event is only marked delivered after it has been published, so
+// subscribers may receive an update more than once but only miss one the broker rejects every attempt to publish.
+type Relay struct {
+	repository repositories.Repository
+	broker     brokers.Broker
+	interval   time.Duration
+	wake       chan struct{}
+}
+
+func NewRelay(repository repositories.Repository, broker brokers.Broker, interval time.Duration) *Relay {
+	return &Relay{
+		repository: repository,
+		broker:     broker,
+		interval:   interval,
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+// Wake asks the relay to deliver pending events now rather than at its next interval.
+func (r *Relay) Wake() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run delivers pending events until ctx is done.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := r.deliver(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("unable to deliver outbox events")
+				break
+			}
+			if n < batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.wake:
+		}
+	}
+}
+
+// deliver publishes a batch of pending events, returning how many were claimed.
+func (r *Relay) deliver(ctx context.Context) (int, error) {
+	var claimed int
+	err := r.repository.Transaction(ctx, func(ctx context.Context) error {
+		events, err := r.repository.ClaimOutboxEvents(ctx, batchSize)
+		if err != nil {
+			return fmt.Errorf("claim outbox events : %w", err)
+		}
+		claimed = len(events)
+
+		// once an event for a journey fails, later events for it are held back until the same time so updates stay
+		// in order. Held back events were not attempted, so their attempts are not counted.
+		retries := map[string]time.Time{}
+		for _, e := range events {
+			journeyID := journeyID(e)
+			if at, held := retries[journeyID]; held {
+				if err := r.repository.RescheduleOutboxEvent(ctx, e.ID, at); err != nil {
+					return fmt.Errorf("reschedule outbox event : %w", err)
+				}
+				continue
+			}
+
+			err := r.publish(ctx, e)
+			if err == nil {
+				if err := r.repository.MarkOutboxEventDelivered(ctx, e.ID); err != nil {
+					return fmt.Errorf("mark outbox event delivered : %w", err)
+				}
+				continue
+			}
+
+			if e.Attempts+1 >= maxAttempts {
+				log.Error().Err(err).Int64("eventId", e.ID).Str("journeyId", journeyID).
+					Int("attempts", e.Attempts+1).Msg("giving up on outbox event")
+				if err := r.repository.FailOutboxEvent(ctx, e.ID); err != nil {
+					return fmt.Errorf("fail outbox event : %w", err)
+				}
+				continue
+			}
+
+			log.Warn().Err(err).Int64("eventId", e.ID).Str("journeyId", journeyID).
+				Int("attempts", e.Attempts+1).Msg("unable to publish outbox event")
+			at := time.Now().Add(backoff(e.Attempts + 1))
+			retries[journeyID] = at
+			if err := r.repository.RetryOutboxEvent(ctx, e.ID, at); err != nil {
+				return fmt.Errorf("retry outbox event : %w", err)
+			}
+		}
+
+		return nil
+	})
+
+	return claimed, err
+}
+
+// publish sends the event to the subscribers of its journey, or to the owner of the geofence it crossed.
+func (r *Relay) publish(ctx context.Context, e *repositories.OutboxEvent) error {
+	if c := e.GeofenceCrossing; c != nil {
+		return r.broker.PublishGeofenceCrossing(ctx, c.UserID, c)
+	}
+
+	return r.broker.Publish(ctx, e.Journey.ID, e.Journey)
+}
+
+func backoff(attempts int) time.Duration {
+	d := minBackoff
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		return maxBackoff
+	}
+
+	return d
+}
+
+func journeyID(e *repositories.OutboxEvent) string {
+	if e.GeofenceCrossing != nil {
+		return e.GeofenceCrossing.JourneyID
+	}
+
+	return e.Journey.ID
+}
@@ -0,0 +1,244 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"github.com/cobbinma/track-api/brokers"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/repositories"
+	"testing"
+	"time"
+)
+
+// fakeRepository serves a fixed batch of outbox events and records what the relay does with each of them.
+type fakeRepository struct {
+	repositories.Repository
+	events    []*repositories.OutboxEvent
+	delivered []int64
+	retried   map[int64]time.Time
+	// rescheduled records events moved without counting an attempt
+	rescheduled map[int64]time.Time
+	failed      []int64
+}
+
+func (r *fakeRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (r *fakeRepository) ClaimOutboxEvents(ctx context.Context, limit uint64) ([]*repositories.OutboxEvent, error) {
+	return r.events, nil
+}
+
+func (r *fakeRepository) MarkOutboxEventDelivered(ctx context.Context, id int64) error {
+	r.delivered = append(r.delivered, id)
+	return nil
+}
+
+func (r *fakeRepository) RetryOutboxEvent(ctx context.Context, id int64, at time.Time) error {
+	if r.retried == nil {
+		r.retried = map[int64]time.Time{}
+	}
+	r.retried[id] = at
+	return nil
+}
+
+func (r *fakeRepository) RescheduleOutboxEvent(ctx context.Context, id int64, at time.Time) error {
+	if r.rescheduled == nil {
+		r.rescheduled = map[int64]time.Time{}
+	}
+	r.rescheduled[id] = at
+	return nil
+}
+
+func (r *fakeRepository) FailOutboxEvent(ctx context.Context, id int64) error {
+	r.failed = append(r.failed, id)
+	return nil
+}
+
+// fakeBroker records the journeys it is asked to publish, failing to publish those of unavailable journeys.
+type fakeBroker struct {
+	brokers.Broker
+	unavailable map[string]bool
+	published   []*model.Journey
+	crossings   map[string][]*model.GeofenceCrossing
+}
+
+func (b *fakeBroker) Publish(ctx context.Context, journeyID string, journey *model.Journey) error {
+	b.published = append(b.published, journey)
+	if b.unavailable[journeyID] {
+		return errors.New("unavailable")
+	}
+	return nil
+}
+
+func (b *fakeBroker) PublishGeofenceCrossing(ctx context.Context, userID string,
+	crossing *model.GeofenceCrossing) error {
+	if b.unavailable[crossing.JourneyID] {
+		return errors.New("unavailable")
+	}
+	if b.crossings == nil {
+		b.crossings = map[string][]*model.GeofenceCrossing{}
+	}
+	b.crossings[userID] = append(b.crossings[userID], crossing)
+	return nil
+}
+
+func event(id int64, journeyID string, attempts int) *repositories.OutboxEvent {
+	return &repositories.OutboxEvent{ID: id, Journey: &model.Journey{ID: journeyID}, Attempts: attempts}
+}
+
+func TestDeliver(t *testing.T) {
+	repository := &fakeRepository{events: []*repositories.OutboxEvent{
+		event(1, "a", 0),
+		event(2, "b", 0),
+		event(3, "a", 0),
+	}}
+	broker := &fakeBroker{}
+
+	n, err := NewRelay(repository, broker, time.Minute).deliver(context.Background())
+	if err != nil {
+		t.Fatalf("unable to deliver: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("expected 3 events claimed, got %d", n)
+	}
+
+	if len(broker.published) != 3 {
+		t.Fatalf("expected 3 events published, got %d", len(broker.published))
+	}
+	for i, e := range repository.events {
+		if broker.published[i] != e.Journey {
+			t.Errorf("expected event %d to be published in claimed order", e.ID)
+		}
+	}
+	if len(repository.delivered) != 3 || len(repository.retried) != 0 {
+		t.Errorf("expected every event delivered, got delivered %v, retried %v", repository.delivered, repository.retried)
+	}
+}
+
+func TestDeliverHoldsBackJourneyAfterFailure(t *testing.T) {
+	repository := &fakeRepository{events: []*repositories.OutboxEvent{
+		event(1, "a", 2),
+		event(2, "b", 0),
+		event(3, "a", 0),
+		event(4, "b", 0),
+	}}
+	broker := &fakeBroker{unavailable: map[string]bool{"a": true}}
+
+	before := time.Now()
+	if _, err := NewRelay(repository, broker, time.Minute).deliver(context.Background()); err != nil {
+		t.Fatalf("unable to deliver: %v", err)
+	}
+	after := time.Now()
+
+	if len(broker.published) != 3 {
+		t.Fatalf("expected the later event of the failed journey not to be published, got %d publishes",
+			len(broker.published))
+	}
+	if len(repository.delivered) != 2 || repository.delivered[0] != 2 || repository.delivered[1] != 4 {
+		t.Errorf("expected the other journey's events delivered, got %v", repository.delivered)
+	}
+
+	at, ok := repository.retried[1]
+	if !ok {
+		t.Fatal("expected the failed event to be retried")
+	}
+	if wait := backoff(3); at.Before(before.Add(wait)) || at.After(after.Add(wait)) {
+		t.Errorf("expected the failed event to be retried after %s, got %s", wait, at.Sub(before))
+	}
+	// the later event was never attempted, so it is moved without counting an attempt
+	if held, ok := repository.rescheduled[3]; !ok || !held.Equal(at) {
+		t.Errorf("expected the later event to be held back until %s, got %s", at, held)
+	}
+	if _, ok := repository.retried[3]; ok {
+		t.Error("expected the held back event not to count an attempt")
+	}
+}
+
+func TestDeliverGivesUpAfterMaxAttempts(t *testing.T) {
+	repository := &fakeRepository{events: []*repositories.OutboxEvent{
+		event(1, "a", maxAttempts-1),
+		event(2, "a", 0),
+	}}
+	broker := &fakeBroker{unavailable: map[string]bool{"a": true}}
+
+	if _, err := NewRelay(repository, broker, time.Minute).deliver(context.Background()); err != nil {
+		t.Fatalf("unable to deliver: %v", err)
+	}
+
+	if len(repository.failed) != 1 || repository.failed[0] != 1 {
+		t.Errorf("expected the event to be given up on after %d attempts, got failed %v", maxAttempts,
+			repository.failed)
+	}
+	if _, ok := repository.retried[1]; ok {
+		t.Error("expected the event given up on not to be retried")
+	}
+	// the later event is published in its own right rather than held back behind one that will never be delivered
+	if len(broker.published) != 2 {
+		t.Errorf("expected the later event to be published, got %d publishes", len(broker.published))
+	}
+	if at, ok := repository.retried[2]; !ok || at.Before(time.Now()) {
+		t.Errorf("expected the later event to be retried after it failed, got %v", at)
+	}
+}
+
+func TestDeliverGeofenceCrossing(t *testing.T) {
+	crossing := &model.GeofenceCrossing{UserID: "viewer", JourneyID: "a", Entered: true}
+	repository := &fakeRepository{events: []*repositories.OutboxEvent{
+		{ID: 1, GeofenceCrossing: crossing},
+		event(2, "a", 0),
+	}}
+	broker := &fakeBroker{}
+
+	if _, err := NewRelay(repository, broker, time.Minute).deliver(context.Background()); err != nil {
+		t.Fatalf("unable to deliver: %v", err)
+	}
+
+	if got := broker.crossings["viewer"]; len(got) != 1 || got[0] != crossing {
+		t.Errorf("expected the crossing to be published to the fence owner, got %v", got)
+	}
+	if len(broker.published) != 1 || len(repository.delivered) != 2 {
+		t.Errorf("expected both events delivered, got %d published and delivered %v", len(broker.published),
+			repository.delivered)
+	}
+}
+
+func TestDeliverHoldsBackJourneyAfterCrossingFails(t *testing.T) {
+	repository := &fakeRepository{events: []*repositories.OutboxEvent{
+		{ID: 1, GeofenceCrossing: &model.GeofenceCrossing{UserID: "viewer", JourneyID: "a"}},
+		event(2, "a", 0),
+	}}
+	broker := &fakeBroker{unavailable: map[string]bool{"a": true}}
+
+	if _, err := NewRelay(repository, broker, time.Minute).deliver(context.Background()); err != nil {
+		t.Fatalf("unable to deliver: %v", err)
+	}
+
+	if len(broker.published) != 0 || len(repository.delivered) != 0 {
+		t.Errorf("expected the journey update to wait for the crossing before it, got delivered %v",
+			repository.delivered)
+	}
+	if at, ok := repository.rescheduled[2]; !ok || !at.Equal(repository.retried[1]) {
+		t.Errorf("expected the journey update to be held back until the crossing is retried, got %v", at)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 1, expected: time.Second},
+		{attempts: 2, expected: 2 * time.Second},
+		{attempts: 4, expected: 8 * time.Second},
+		{attempts: 6, expected: 32 * time.Second},
+		{attempts: 7, expected: time.Minute},
+		{attempts: 100, expected: time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := backoff(tt.attempts); got != tt.expected {
+			t.Errorf("expected backoff after %d attempts to be %s, got %s", tt.attempts, tt.expected, got)
+		}
+	}
+}
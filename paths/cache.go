@@ -0,0 +1,68 @@
+package paths
+
+import (
+	"container/list"
+	"github.com/cobbinma/track-api/geo"
+	"sync"
+)
+
+// Key identifies a simplified path. A journey's version changes whenever its track does, so paths cached for earlier
+// versions are never returned and age out of the cache.
+type Key struct {
+	JourneyID string
+	Version   int
+	Tolerance float64
+}
+
+type entry struct {
+	key    Key
+	points []geo.Point
+}
+
+// Cache holds the most recently used simplified paths.
+type Cache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[Key]*list.Element
+}
+
+func NewCache(size int) *Cache {
+	return &Cache{
+		size:    size,
+		order:   list.New(),
+		entries: map[Key]*list.Element{},
+	}
+}
+
+func (c *Cache) Get(key Key) ([]geo.Point, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+
+	return e.Value.(*entry).points, true
+}
+
+// Add caches the path, evicting the least recently used path once the cache is full.
+func (c *Cache) Add(key Key, points []geo.Point) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*entry).points = points
+		c.order.MoveToFront(e)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&entry{key: key, points: points})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry).key)
+	}
+}
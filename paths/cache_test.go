@@ -0,0 +1,56 @@
+package paths
+
+import (
+	"github.com/cobbinma/track-api/geo"
+	"testing"
+)
+
+func TestCache(t *testing.T) {
+	cache := NewCache(2)
+	first := Key{JourneyID: "a", Version: 1, Tolerance: 10}
+	path := []geo.Point{{Lat: 51.5, Lng: -0.12}}
+
+	cache.Add(first, path)
+	if got, ok := cache.Get(first); !ok || len(got) != 1 || got[0] != path[0] {
+		t.Fatalf("expected the cached path, got %v, %v", got, ok)
+	}
+
+	if _, ok := cache.Get(Key{JourneyID: "a", Version: 2, Tolerance: 10}); ok {
+		t.Error("expected no path for a later version of the journey")
+	}
+	if _, ok := cache.Get(Key{JourneyID: "a", Version: 1, Tolerance: 5}); ok {
+		t.Error("expected no path for another tolerance")
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewCache(2)
+	a, b, c := Key{JourneyID: "a"}, Key{JourneyID: "b"}, Key{JourneyID: "c"}
+
+	cache.Add(a, nil)
+	cache.Add(b, nil)
+	// using a makes b the least recently used path
+	cache.Get(a)
+	cache.Add(c, nil)
+
+	if _, ok := cache.Get(b); ok {
+		t.Error("expected the least recently used path to be evicted")
+	}
+	for _, key := range []Key{a, c} {
+		if _, ok := cache.Get(key); !ok {
+			t.Errorf("expected path for %s to be cached", key.JourneyID)
+		}
+	}
+}
+
+func TestCacheAddReplacesPath(t *testing.T) {
+	cache := NewCache(1)
+	key := Key{JourneyID: "a"}
+
+	cache.Add(key, []geo.Point{{Lat: 1, Lng: 1}})
+	cache.Add(key, []geo.Point{{Lat: 2, Lng: 2}})
+
+	if got, ok := cache.Get(key); !ok || len(got) != 1 || got[0].Lat != 2 {
+		t.Errorf("expected the replaced path, got %v, %v", got, ok)
+	}
+}
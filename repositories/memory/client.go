@@ -0,0 +1,990 @@
+package memory
+
+import (
+	"context"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/lifecycle"
+	"github.com/cobbinma/track-api/repositories"
+	"sort"
+	"sync"
+	"time"
+)
+
+var _ repositories.Repository = (*Client)(nil)
+
+// Client is an in-memory repository for tests and local development. Nothing is persisted between restarts.
+// Transactions are not isolated: Transaction never rolls back changes made before fn fails and GetJourneyForUpdate
+// takes no lock, so concurrent updates to a journey may interleave.
+type Client struct {
+	mu         sync.RWMutex
+	journeys   map[string]*model.Journey
+	positions  map[string][]model.TrackPoint
+	viewers    map[string]map[string]bool
+	shareLinks map[string]*model.ShareLink
+	geofences  map[string]*model.Geofence
+	logEvents  []*model.JourneyLogEvent
+	logSeq     int64
+	outbox     []*outboxEvent
+	outboxID   int64
+	// downsampled holds the journeys whose tracks have been down-sampled.
+	downsampled map[string]bool
+}
+
+type outboxEvent struct {
+	repositories.OutboxEvent
+	availableAt time.Time
+	deliveredAt *time.Time
+	failedAt    *time.Time
+}
+
+func NewMemory() *Client {
+	return &Client{
+		journeys:    map[string]*model.Journey{},
+		positions:   map[string][]model.TrackPoint{},
+		viewers:     map[string]map[string]bool{},
+		shareLinks:  map[string]*model.ShareLink{},
+		geofences:   map[string]*model.Geofence{},
+		downsampled: map[string]bool{},
+	}
+}
+
+func copyJourney(j *model.Journey) *model.Journey {
+	c := *j
+	if j.User != nil {
+		user := *j.User
+		c.User = &user
+	}
+	if j.Position != nil {
+		c.Position = copyPosition(j.Position)
+	}
+	c.Stats = copyStats(j.Stats)
+	if j.Destination != nil {
+		destination := *j.Destination
+		c.Destination = &destination
+	}
+	if j.RemainingDistance != nil {
+		remainingDistance := *j.RemainingDistance
+		c.RemainingDistance = &remainingDistance
+	}
+	if j.Eta != nil {
+		eta := *j.Eta
+		c.Eta = &eta
+	}
+	if j.CompletedAt != nil {
+		completedAt := *j.CompletedAt
+		c.CompletedAt = &completedAt
+	}
+
+	return &c
+}
+
+func copyPosition(p *model.Position) *model.Position {
+	c := *p
+	for _, f := range []**float64{&c.Accuracy, &c.Altitude, &c.Speed, &c.Heading} {
+		if *f != nil {
+			v := **f
+			*f = &v
+		}
+	}
+	if p.RecordedAt != nil {
+		recordedAt := *p.RecordedAt
+		c.RecordedAt = &recordedAt
+	}
+
+	return &c
+}
+
+func copyLogEvent(e *model.JourneyLogEvent) *model.JourneyLogEvent {
+	c := *e
+	if e.Actor != nil {
+		actor := *e.Actor
+		c.Actor = &actor
+	}
+	if e.PreviousStatus != nil {
+		previousStatus := *e.PreviousStatus
+		c.PreviousStatus = &previousStatus
+	}
+	if e.Status != nil {
+		status := *e.Status
+		c.Status = &status
+	}
+	if e.Event != nil {
+		event := *e.Event
+		c.Event = &event
+	}
+	if e.Position != nil {
+		c.Position = copyPosition(e.Position)
+	}
+	if e.Viewer != nil {
+		viewer := *e.Viewer
+		c.Viewer = &viewer
+	}
+	if e.ShareLinkID != nil {
+		shareLinkID := *e.ShareLinkID
+		c.ShareLinkID = &shareLinkID
+	}
+
+	return &c
+}
+
+func copyStats(s *model.JourneyStats) *model.JourneyStats {
+	c := &model.JourneyStats{}
+	if s != nil {
+		*c = *s
+	}
+	if c.StartedAt != nil {
+		startedAt := *c.StartedAt
+		c.StartedAt = &startedAt
+	}
+	if c.EndedAt != nil {
+		endedAt := *c.EndedAt
+		c.EndedAt = &endedAt
+	}
+
+	return c
+}
+
+func copyGeofence(g *model.Geofence) *model.Geofence {
+	c := *g
+	if g.User != nil {
+		user := *g.User
+		c.User = &user
+	}
+	if g.Center != nil {
+		center := *g.Center
+		c.Center = &center
+	}
+	if g.Radius != nil {
+		radius := *g.Radius
+		c.Radius = &radius
+	}
+	if g.Polygon != nil {
+		c.Polygon = make([]*model.Coordinates, 0, len(g.Polygon))
+		for _, p := range g.Polygon {
+			vertex := *p
+			c.Polygon = append(c.Polygon, &vertex)
+		}
+	}
+
+	return &c
+}
+
+func (c *Client) GetJourney(ctx context.Context, id string) (*model.Journey, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	j, ok := c.journeys[id]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+
+	return copyJourney(j), nil
+}
+
+// GetJourneyForUpdate returns the journey; transactions are not isolated so there is nothing to lock.
+func (c *Client) GetJourneyForUpdate(ctx context.Context, id string) (*model.Journey, error) {
+	return c.GetJourney(ctx, id)
+}
+
+func (c *Client) ListJourneys(ctx context.Context, userID string, status *model.JourneyStatus, after *repositories.JourneyCursor, limit uint64) ([]*model.Journey, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	journeys := []*model.Journey{}
+	for _, j := range c.journeys {
+		if j.User.ID != userID || (status != nil && j.Status != *status) {
+			continue
+		}
+		if after != nil && !newerJourney(after, journeyCursor(j)) {
+			continue
+		}
+		journeys = append(journeys, copyJourney(j))
+	}
+
+	sort.Slice(journeys, func(i, j int) bool {
+		return newerJourney(journeyCursor(journeys[i]), journeyCursor(journeys[j]))
+	})
+	if uint64(len(journeys)) > limit {
+		journeys = journeys[:limit]
+	}
+
+	return journeys, nil
+}
+
+func journeyCursor(j *model.Journey) *repositories.JourneyCursor {
+	return &repositories.JourneyCursor{CreatedAt: j.CreatedAt, ID: j.ID}
+}
+
+// newerJourney reports whether a comes before b in a history ordered newest first.
+func newerJourney(a, b *repositories.JourneyCursor) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.After(b.CreatedAt)
+	}
+
+	return a.ID > b.ID
+}
+
+func (c *Client) GetActiveJourney(ctx context.Context, userID string) (*model.Journey, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var active *model.Journey
+	for _, j := range c.journeys {
+		if j.User.ID != userID || j.Status != model.JourneyStatusActive {
+			continue
+		}
+		if active == nil || j.UpdatedAt.After(active.UpdatedAt) {
+			active = j
+		}
+	}
+
+	if active == nil {
+		return nil, repositories.ErrNotFound
+	}
+
+	return copyJourney(active), nil
+}
+
+func (c *Client) ListIdleJourneys(ctx context.Context, before time.Time, limit uint64) ([]*model.Journey, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	journeys := []*model.Journey{}
+	for _, j := range c.journeys {
+		if j.Status != model.JourneyStatusActive || !j.UpdatedAt.Before(before) {
+			continue
+		}
+		journeys = append(journeys, copyJourney(j))
+	}
+
+	sort.Slice(journeys, func(i, j int) bool { return journeys[i].UpdatedAt.Before(journeys[j].UpdatedAt) })
+	if uint64(len(journeys)) > limit {
+		journeys = journeys[:limit]
+	}
+
+	return journeys, nil
+}
+
+func (c *Client) CreateJourney(ctx context.Context, journey *model.Journey) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.journeys[journey.ID] = copyJourney(journey)
+
+	return nil
+}
+
+func (c *Client) UpdatePosition(ctx context.Context, id string, position *model.Position) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	j, ok := c.journeys[id]
+	if !ok {
+		return repositories.ErrNotFound
+	}
+
+	j.Position = nil
+	if position != nil {
+		j.Position = copyPosition(position)
+	}
+	j.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+func (c *Client) AddPosition(ctx context.Context, id string, position *model.Position) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	j, ok := c.journeys[id]
+	if !ok {
+		return repositories.ErrNotFound
+	}
+
+	j.Position = copyPosition(position)
+	j.UpdatedAt = time.Now().UTC()
+	if j.Position.RecordedAt == nil {
+		recordedAt := time.Now().UTC()
+		j.Position.RecordedAt = &recordedAt
+	}
+	p := copyPosition(j.Position)
+	c.positions[id] = append(c.positions[id], model.TrackPoint{
+		Seq:        len(c.positions[id]) + 1,
+		Lat:        p.Lat,
+		Lng:        p.Lng,
+		Accuracy:   p.Accuracy,
+		Altitude:   p.Altitude,
+		Speed:      p.Speed,
+		Heading:    p.Heading,
+		RecordedAt: *p.RecordedAt,
+	})
+
+	return nil
+}
+
+func (c *Client) AddPositions(ctx context.Context, id string, points []*model.TrackPoint) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.journeys[id]; !ok {
+		return repositories.ErrNotFound
+	}
+
+	for _, p := range points {
+		c.positions[id] = append(c.positions[id], model.TrackPoint{
+			Seq:        len(c.positions[id]) + 1,
+			Lat:        p.Lat,
+			Lng:        p.Lng,
+			Accuracy:   p.Accuracy,
+			Altitude:   p.Altitude,
+			Speed:      p.Speed,
+			Heading:    p.Heading,
+			RecordedAt: p.RecordedAt,
+		})
+	}
+
+	return nil
+}
+
+func (c *Client) ListPositions(ctx context.Context, id string, since *time.Time, after *int, limit uint64) ([]*model.TrackPoint, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	points := []*model.TrackPoint{}
+	for _, p := range c.positions[id] {
+		if uint64(len(points)) == limit {
+			break
+		}
+		if (since != nil && p.RecordedAt.Before(*since)) || (after != nil && p.Seq <= *after) {
+			continue
+		}
+		point := p
+		points = append(points, &point)
+	}
+
+	return points, nil
+}
+
+func (c *Client) ListRecentPositions(ctx context.Context, id string, since time.Time, limit uint64) ([]*model.TrackPoint, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	points := []*model.TrackPoint{}
+	for _, p := range c.positions[id] {
+		if p.RecordedAt.Before(since) {
+			continue
+		}
+		point := p
+		points = append(points, &point)
+	}
+	if uint64(len(points)) > limit {
+		points = points[uint64(len(points))-limit:]
+	}
+
+	return points, nil
+}
+
+func (c *Client) StreamPositions(ctx context.Context, id string, fn func(point *model.TrackPoint) error) error {
+	c.mu.RLock()
+	points := make([]model.TrackPoint, len(c.positions[id]))
+	copy(points, c.positions[id])
+	c.mu.RUnlock()
+
+	for i := range points {
+		if err := fn(&points[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateStatus(ctx context.Context, id string, status model.JourneyStatus) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	j, ok := c.journeys[id]
+	if !ok {
+		return repositories.ErrNotFound
+	}
+
+	j.Status = status
+	j.UpdatedAt = time.Now().UTC()
+	if lifecycle.Final(status) {
+		completedAt := j.UpdatedAt
+		j.CompletedAt = &completedAt
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateStats(ctx context.Context, id string, stats *model.JourneyStats) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	j, ok := c.journeys[id]
+	if !ok {
+		return repositories.ErrNotFound
+	}
+
+	j.Stats = copyStats(stats)
+
+	return nil
+}
+
+func (c *Client) UpdateETA(ctx context.Context, id string, remainingDistance *float64, eta *time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	j, ok := c.journeys[id]
+	if !ok {
+		return repositories.ErrNotFound
+	}
+
+	j.RemainingDistance, j.Eta = nil, nil
+	if remainingDistance != nil {
+		d := *remainingDistance
+		j.RemainingDistance = &d
+	}
+	if eta != nil {
+		t := *eta
+		j.Eta = &t
+	}
+
+	return nil
+}
+
+func (c *Client) AddViewer(ctx context.Context, journeyID, userID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.journeys[journeyID]; !ok {
+		return repositories.ErrNotFound
+	}
+
+	if c.viewers[journeyID] == nil {
+		c.viewers[journeyID] = map[string]bool{}
+	}
+	c.viewers[journeyID][userID] = true
+
+	return nil
+}
+
+func (c *Client) RemoveViewer(ctx context.Context, journeyID, userID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.viewers[journeyID], userID)
+
+	return nil
+}
+
+func (c *Client) IsViewer(ctx context.Context, journeyID, userID string) (bool, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.viewers[journeyID][userID], nil
+}
+
+func (c *Client) ListViewers(ctx context.Context, journeyID string) ([]string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	viewers := []string{}
+	for userID := range c.viewers[journeyID] {
+		viewers = append(viewers, userID)
+	}
+	sort.Strings(viewers)
+
+	return viewers, nil
+}
+
+func (c *Client) CreateShareLink(ctx context.Context, link *model.ShareLink) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.journeys[link.JourneyID]; !ok {
+		return repositories.ErrNotFound
+	}
+
+	l := *link
+	c.shareLinks[link.ID] = &l
+
+	return nil
+}
+
+func (c *Client) GetShareLink(ctx context.Context, id string) (*model.ShareLink, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	l, ok := c.shareLinks[id]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+
+	link := *l
+	return &link, nil
+}
+
+func (c *Client) RevokeShareLink(ctx context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	l, ok := c.shareLinks[id]
+	if !ok {
+		return repositories.ErrNotFound
+	}
+
+	l.Revoked = true
+
+	return nil
+}
+
+func (c *Client) AddJourneyLogEvent(ctx context.Context, event *model.JourneyLogEvent) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.logSeq++
+	event.Seq = c.logSeq
+	c.logEvents = append(c.logEvents, copyLogEvent(event))
+
+	return nil
+}
+
+func (c *Client) ListJourneyLogEvents(ctx context.Context, journeyID string, after *int64, limit uint64) ([]*model.JourneyLogEvent, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	events := []*model.JourneyLogEvent{}
+	for _, e := range c.logEvents {
+		if uint64(len(events)) == limit {
+			break
+		}
+		if e.JourneyID != journeyID || (after != nil && e.Seq <= *after) {
+			continue
+		}
+		events = append(events, copyLogEvent(e))
+	}
+
+	return events, nil
+}
+
+func (c *Client) IncrementVersion(ctx context.Context, id string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	j, ok := c.journeys[id]
+	if !ok {
+		return 0, repositories.ErrNotFound
+	}
+
+	j.Version++
+
+	return j.Version, nil
+}
+
+func (c *Client) ListJourneyVersions(ctx context.Context, id string, since int, limit uint64) ([]*model.Journey, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	journeys := []*model.Journey{}
+	for _, e := range c.outbox {
+		if e.Journey != nil && e.Journey.ID == id && e.Journey.Version > since {
+			journeys = append(journeys, copyJourney(e.Journey))
+		}
+	}
+
+	sort.SliceStable(journeys, func(i, j int) bool { return journeys[i].Version < journeys[j].Version })
+	if uint64(len(journeys)) > limit {
+		journeys = journeys[:limit]
+	}
+
+	return journeys, nil
+}
+
+func (c *Client) AddOutboxEvent(ctx context.Context, journey *model.Journey) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.outboxID++
+	c.outbox = append(c.outbox, &outboxEvent{
+		OutboxEvent: repositories.OutboxEvent{
+			ID:      c.outboxID,
+			Journey: copyJourney(journey),
+		},
+		availableAt: time.Now(),
+	})
+
+	return nil
+}
+
+func (c *Client) AddGeofenceOutboxEvent(ctx context.Context, crossing *model.GeofenceCrossing) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cr := *crossing
+	c.outboxID++
+	c.outbox = append(c.outbox, &outboxEvent{
+		OutboxEvent: repositories.OutboxEvent{
+			ID:               c.outboxID,
+			GeofenceCrossing: &cr,
+		},
+		availableAt: time.Now(),
+	})
+
+	return nil
+}
+
+func (c *Client) ClaimOutboxEvents(ctx context.Context, limit uint64) ([]*repositories.OutboxEvent, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	events := []*repositories.OutboxEvent{}
+	for _, e := range c.outbox {
+		if uint64(len(events)) == limit {
+			break
+		}
+		if e.deliveredAt != nil || e.failedAt != nil || e.availableAt.After(now) {
+			continue
+		}
+		event := &repositories.OutboxEvent{
+			ID:       e.ID,
+			Attempts: e.Attempts,
+		}
+		if e.Journey != nil {
+			event.Journey = copyJourney(e.Journey)
+		}
+		if e.GeofenceCrossing != nil {
+			crossing := *e.GeofenceCrossing
+			event.GeofenceCrossing = &crossing
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (c *Client) MarkOutboxEventDelivered(ctx context.Context, id int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.outboxEvent(id)
+	if e == nil {
+		return repositories.ErrNotFound
+	}
+
+	deliveredAt := time.Now()
+	e.deliveredAt = &deliveredAt
+
+	return nil
+}
+
+func (c *Client) RetryOutboxEvent(ctx context.Context, id int64, at time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.outboxEvent(id)
+	if e == nil {
+		return repositories.ErrNotFound
+	}
+
+	e.Attempts++
+	e.availableAt = at
+
+	return nil
+}
+
+func (c *Client) RescheduleOutboxEvent(ctx context.Context, id int64, at time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.outboxEvent(id)
+	if e == nil {
+		return repositories.ErrNotFound
+	}
+
+	e.availableAt = at
+
+	return nil
+}
+
+func (c *Client) FailOutboxEvent(ctx context.Context, id int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.outboxEvent(id)
+	if e == nil {
+		return repositories.ErrNotFound
+	}
+
+	failedAt := time.Now()
+	e.Attempts++
+	e.failedAt = &failedAt
+
+	return nil
+}
+
+// outboxEvent returns the outbox event with the id, or nil if there is none. The caller must hold the lock.
+func (c *Client) outboxEvent(id int64) *outboxEvent {
+	i := sort.Search(len(c.outbox), func(i int) bool { return c.outbox[i].ID >= id })
+	if i == len(c.outbox) || c.outbox[i].ID != id {
+		return nil
+	}
+
+	return c.outbox[i]
+}
+
+func (c *Client) CreateGeofence(ctx context.Context, fence *model.Geofence) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.geofences[fence.ID] = copyGeofence(fence)
+
+	return nil
+}
+
+func (c *Client) GetGeofence(ctx context.Context, id string) (*model.Geofence, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	g, ok := c.geofences[id]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+
+	return copyGeofence(g), nil
+}
+
+func (c *Client) ListGeofences(ctx context.Context, userIDs []string) ([]*model.Geofence, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	users := map[string]bool{}
+	for _, id := range userIDs {
+		users[id] = true
+	}
+
+	fences := []*model.Geofence{}
+	for _, g := range c.geofences {
+		if users[g.User.ID] {
+			fences = append(fences, copyGeofence(g))
+		}
+	}
+	sort.Slice(fences, func(i, j int) bool { return fences[i].ID < fences[j].ID })
+
+	return fences, nil
+}
+
+func (c *Client) UpdateGeofence(ctx context.Context, fence *model.Geofence) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.geofences[fence.ID]; !ok {
+		return repositories.ErrNotFound
+	}
+
+	c.geofences[fence.ID] = copyGeofence(fence)
+
+	return nil
+}
+
+func (c *Client) DeleteGeofence(ctx context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.geofences, id)
+
+	return nil
+}
+
+func (c *Client) CountJourneysUpdatedBefore(ctx context.Context, status model.JourneyStatus, before time.Time) (int64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var n int64
+	for _, j := range c.journeys {
+		if j.Status == status && j.UpdatedAt.Before(before) {
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+func (c *Client) DeleteJourneysUpdatedBefore(ctx context.Context, status model.JourneyStatus, before time.Time, limit uint64) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	journeys := []*model.Journey{}
+	for _, j := range c.journeys {
+		if j.Status == status && j.UpdatedAt.Before(before) {
+			journeys = append(journeys, j)
+		}
+	}
+	sort.Slice(journeys, func(i, j int) bool { return journeys[i].UpdatedAt.Before(journeys[j].UpdatedAt) })
+	if uint64(len(journeys)) > limit {
+		journeys = journeys[:limit]
+	}
+
+	deleted := map[string]bool{}
+	for _, j := range journeys {
+		deleted[j.ID] = true
+		delete(c.journeys, j.ID)
+		delete(c.positions, j.ID)
+		delete(c.viewers, j.ID)
+		delete(c.downsampled, j.ID)
+	}
+	for id, link := range c.shareLinks {
+		if deleted[link.JourneyID] {
+			delete(c.shareLinks, id)
+		}
+	}
+	logEvents := c.logEvents[:0]
+	for _, e := range c.logEvents {
+		if !deleted[e.JourneyID] {
+			logEvents = append(logEvents, e)
+		}
+	}
+	c.logEvents = logEvents
+	outbox := c.outbox[:0]
+	for _, e := range c.outbox {
+		if !deleted[outboxJourneyID(e)] {
+			outbox = append(outbox, e)
+		}
+	}
+	c.outbox = outbox
+
+	return int64(len(journeys)), nil
+}
+
+func outboxJourneyID(e *outboxEvent) string {
+	if e.GeofenceCrossing != nil {
+		return e.GeofenceCrossing.JourneyID
+	}
+
+	return e.Journey.ID
+}
+
+// excessPositions returns the indexes of the positions in the track that down-sampling to the interval removes,
+// keeping the first position recorded in each interval and the last position. A zero interval removes them all.
+func excessPositions(points []model.TrackPoint, interval time.Duration) []int {
+	excess := []int{}
+	for i, p := range points {
+		if interval > 0 {
+			if i == 0 || i == len(points)-1 ||
+				p.RecordedAt.UnixNano()/int64(interval) != points[i-1].RecordedAt.UnixNano()/int64(interval) {
+				continue
+			}
+		}
+		excess = append(excess, i)
+	}
+
+	return excess
+}
+
+func (c *Client) CountDownsamplePositions(ctx context.Context, before time.Time, interval time.Duration) (int64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var n int64
+	for id, j := range c.journeys {
+		if j.CompletedAt != nil && j.CompletedAt.Before(before) && !c.downsampled[id] {
+			n += int64(len(excessPositions(c.positions[id], interval)))
+		}
+	}
+
+	return n, nil
+}
+
+func (c *Client) DownsampleJourneys(ctx context.Context, before time.Time, interval time.Duration, limit uint64) (int64, int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	journeys := []*model.Journey{}
+	for id, j := range c.journeys {
+		if j.CompletedAt != nil && j.CompletedAt.Before(before) && !c.downsampled[id] {
+			journeys = append(journeys, j)
+		}
+	}
+	sort.Slice(journeys, func(i, j int) bool { return journeys[i].CompletedAt.Before(*journeys[j].CompletedAt) })
+	if uint64(len(journeys)) > limit {
+		journeys = journeys[:limit]
+	}
+
+	var deleted int64
+	downsampled := map[string]bool{}
+	for _, j := range journeys {
+		removed := map[int]bool{}
+		for _, i := range excessPositions(c.positions[j.ID], interval) {
+			removed[i] = true
+		}
+		points := make([]model.TrackPoint, 0, len(c.positions[j.ID])-len(removed))
+		for i, p := range c.positions[j.ID] {
+			if !removed[i] {
+				points = append(points, p)
+			}
+		}
+		c.positions[j.ID] = points
+		deleted += int64(len(removed))
+		j.Version++
+		downsampled[j.ID] = true
+		c.downsampled[j.ID] = true
+	}
+	for _, e := range c.logEvents {
+		if downsampled[e.JourneyID] {
+			e.Position = nil
+		}
+	}
+	outbox := c.outbox[:0]
+	for _, e := range c.outbox {
+		if !downsampled[outboxJourneyID(e)] {
+			outbox = append(outbox, e)
+		}
+	}
+	c.outbox = outbox
+
+	return int64(len(journeys)), deleted, nil
+}
+
+func (c *Client) CountOutboxEventsSettledBefore(ctx context.Context, before time.Time) (int64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var n int64
+	for _, e := range c.outbox {
+		if e.settledBefore(before) {
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+func (c *Client) DeleteOutboxEventsSettledBefore(ctx context.Context, before time.Time, limit uint64) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var deleted int64
+	outbox := c.outbox[:0]
+	for _, e := range c.outbox {
+		if uint64(deleted) < limit && e.settledBefore(before) {
+			deleted++
+			continue
+		}
+		outbox = append(outbox, e)
+	}
+	c.outbox = outbox
+
+	return deleted, nil
+}
+
+// settledBefore reports whether the event was delivered or given up on before the given time.
+func (e *outboxEvent) settledBefore(before time.Time) bool {
+	return (e.deliveredAt != nil && e.deliveredAt.Before(before)) || (e.failedAt != nil && e.failedAt.Before(before))
+}
+
+// Transaction runs fn without isolation; changes made before fn returns an error are not rolled back.
+func (c *Client) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
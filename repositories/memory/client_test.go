@@ -0,0 +1,10 @@
+package memory
+
+import (
+	"github.com/cobbinma/track-api/repositories/repositorytest"
+	"testing"
+)
+
+func TestClient(t *testing.T) {
+	repositorytest.Run(t, NewMemory())
+}
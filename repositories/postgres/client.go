@@ -3,40 +3,287 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/lifecycle"
+	"github.com/cobbinma/track-api/repositories"
+	"github.com/cobbinma/track-api/stats"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/jmoiron/sqlx"
 	"net/url"
+	"time"
 )
 
+var _ repositories.Repository = (*Client)(nil)
+
+// insertBatchSize bounds the rows in a single insert, keeping it under the Postgres limit on bind parameters.
+const insertBatchSize = 1000
+
 type Client struct {
 	db *sqlx.DB
 }
 
+var (
+	journeyColumns = []string{"id", "user_id", "status", "lat", "lng", "accuracy", "altitude", "speed", "heading",
+		"position_recorded_at", "version", "distance_meters", "max_speed", "started_at", "ended_at",
+		"destination_lat", "destination_lng", "remaining_distance", "eta", "created_at", "completed_at", "updated_at"}
+	positionColumns = []string{"seq", "lat", "lng", "accuracy", "altitude", "speed", "heading", "recorded_at"}
+)
+
 type journey struct {
-	ID     string          `db:"id"`
-	UserId string          `db:"user_id"`
-	Status string          `db:"status"`
-	Lat    sql.NullFloat64 `db:"lat"`
-	Lng    sql.NullFloat64 `db:"lng"`
+	ID                 string          `db:"id"`
+	UserId             string          `db:"user_id"`
+	Status             string          `db:"status"`
+	Lat                sql.NullFloat64 `db:"lat"`
+	Lng                sql.NullFloat64 `db:"lng"`
+	Accuracy           sql.NullFloat64 `db:"accuracy"`
+	Altitude           sql.NullFloat64 `db:"altitude"`
+	Speed              sql.NullFloat64 `db:"speed"`
+	Heading            sql.NullFloat64 `db:"heading"`
+	PositionRecordedAt sql.NullTime    `db:"position_recorded_at"`
+	Version            int             `db:"version"`
+	DistanceMeters     float64         `db:"distance_meters"`
+	MaxSpeed           float64         `db:"max_speed"`
+	StartedAt          sql.NullTime    `db:"started_at"`
+	EndedAt            sql.NullTime    `db:"ended_at"`
+	DestinationLat     sql.NullFloat64 `db:"destination_lat"`
+	DestinationLng     sql.NullFloat64 `db:"destination_lng"`
+	RemainingDistance  sql.NullFloat64 `db:"remaining_distance"`
+	Eta                sql.NullTime    `db:"eta"`
+	CreatedAt          time.Time       `db:"created_at"`
+	CompletedAt        sql.NullTime    `db:"completed_at"`
+	UpdatedAt          time.Time       `db:"updated_at"`
 }
 
 func (j journey) Position() *model.Position {
 	if j.Lat.Valid && j.Lng.Valid {
-		return &model.Position{
-			Lat: j.Lat.Float64,
-			Lng: j.Lng.Float64,
+		p := &model.Position{
+			Lat:      j.Lat.Float64,
+			Lng:      j.Lng.Float64,
+			Accuracy: nullableFloat(j.Accuracy),
+			Altitude: nullableFloat(j.Altitude),
+			Speed:    nullableFloat(j.Speed),
+			Heading:  nullableFloat(j.Heading),
 		}
+		if j.PositionRecordedAt.Valid {
+			recordedAt := j.PositionRecordedAt.Time
+			p.RecordedAt = &recordedAt
+		}
+
+		return p
 	}
 
 	return nil
 }
 
+type position struct {
+	Seq        int             `db:"seq"`
+	Lat        float64         `db:"lat"`
+	Lng        float64         `db:"lng"`
+	Accuracy   sql.NullFloat64 `db:"accuracy"`
+	Altitude   sql.NullFloat64 `db:"altitude"`
+	Speed      sql.NullFloat64 `db:"speed"`
+	Heading    sql.NullFloat64 `db:"heading"`
+	RecordedAt time.Time       `db:"recorded_at"`
+}
+
+func (p position) TrackPoint() *model.TrackPoint {
+	return &model.TrackPoint{
+		Seq:        p.Seq,
+		Lat:        p.Lat,
+		Lng:        p.Lng,
+		Accuracy:   nullableFloat(p.Accuracy),
+		Altitude:   nullableFloat(p.Altitude),
+		Speed:      nullableFloat(p.Speed),
+		Heading:    nullableFloat(p.Heading),
+		RecordedAt: p.RecordedAt,
+	}
+}
+
+func nullFloat(f *float64) sql.NullFloat64 {
+	if f == nil {
+		return sql.NullFloat64{}
+	}
+
+	return sql.NullFloat64{Float64: *f, Valid: true}
+}
+
+func nullableFloat(f sql.NullFloat64) *float64 {
+	if !f.Valid {
+		return nil
+	}
+
+	return &f.Float64
+}
+
+type shareLink struct {
+	ID        string       `db:"id"`
+	JourneyID string       `db:"journey_id"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	RevokedAt sql.NullTime `db:"revoked_at"`
+}
+
+const (
+	outboxKindJourney          = "journey"
+	outboxKindGeofenceCrossing = "geofence_crossing"
+)
+
+type outboxEvent struct {
+	ID       int64  `db:"id"`
+	Kind     string `db:"kind"`
+	Payload  []byte `db:"payload"`
+	Attempts int    `db:"attempts"`
+}
+
+type journeyLogEvent struct {
+	ID        int64          `db:"id"`
+	JourneyID string         `db:"journey_id"`
+	Action    string         `db:"action"`
+	ActorID   sql.NullString `db:"actor_id"`
+	Data      []byte         `db:"data"`
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+// journeyLogData holds the details of a journey log event that depend on its action.
+type journeyLogData struct {
+	PreviousStatus *model.JourneyStatus `json:"previousStatus,omitempty"`
+	Status         *model.JourneyStatus `json:"status,omitempty"`
+	Event          *model.JourneyEvent  `json:"event,omitempty"`
+	Position       *model.Position      `json:"position,omitempty"`
+	ViewerID       *string              `json:"viewerId,omitempty"`
+	ShareLinkID    *string              `json:"shareLinkId,omitempty"`
+}
+
+func (e journeyLogEvent) JourneyLogEvent() (*model.JourneyLogEvent, error) {
+	var data journeyLogData
+	if err := json.Unmarshal(e.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal data : %w", err)
+	}
+
+	event := &model.JourneyLogEvent{
+		Seq:            e.ID,
+		JourneyID:      e.JourneyID,
+		Action:         model.JourneyLogAction(e.Action),
+		PreviousStatus: data.PreviousStatus,
+		Status:         data.Status,
+		Event:          data.Event,
+		Position:       data.Position,
+		ShareLinkID:    data.ShareLinkID,
+		CreatedAt:      e.CreatedAt,
+	}
+	if e.ActorID.Valid {
+		event.Actor = &model.User{ID: e.ActorID.String}
+	}
+	if data.ViewerID != nil {
+		event.Viewer = &model.User{ID: *data.ViewerID}
+	}
+
+	return event, nil
+}
+
+type geofence struct {
+	ID        string          `db:"id"`
+	UserID    string          `db:"user_id"`
+	Name      string          `db:"name"`
+	Shape     string          `db:"shape"`
+	CenterLat sql.NullFloat64 `db:"center_lat"`
+	CenterLng sql.NullFloat64 `db:"center_lng"`
+	Radius    sql.NullFloat64 `db:"radius"`
+	Polygon   sql.NullString  `db:"polygon"`
+}
+
+func newGeofence(fence *model.Geofence) (geofence, error) {
+	g := geofence{
+		ID:     fence.ID,
+		UserID: fence.User.ID,
+		Name:   fence.Name,
+		Shape:  fence.Shape.String(),
+		Radius: nullFloat(fence.Radius),
+	}
+	if fence.Center != nil {
+		g.CenterLat = sql.NullFloat64{Float64: fence.Center.Lat, Valid: true}
+		g.CenterLng = sql.NullFloat64{Float64: fence.Center.Lng, Valid: true}
+	}
+	if fence.Polygon != nil {
+		polygon, err := json.Marshal(fence.Polygon)
+		if err != nil {
+			return geofence{}, fmt.Errorf("marshal polygon : %w", err)
+		}
+		g.Polygon = sql.NullString{String: string(polygon), Valid: true}
+	}
+
+	return g, nil
+}
+
+func (g geofence) Geofence() (*model.Geofence, error) {
+	fence := &model.Geofence{
+		ID:     g.ID,
+		User:   &model.User{ID: g.UserID},
+		Name:   g.Name,
+		Shape:  model.GeofenceShape(g.Shape),
+		Radius: nullableFloat(g.Radius),
+	}
+	if g.CenterLat.Valid && g.CenterLng.Valid {
+		fence.Center = &model.Coordinates{Lat: g.CenterLat.Float64, Lng: g.CenterLng.Float64}
+	}
+	if g.Polygon.Valid {
+		if err := json.Unmarshal([]byte(g.Polygon.String), &fence.Polygon); err != nil {
+			return nil, fmt.Errorf("unmarshal polygon : %w", err)
+		}
+	}
+
+	return fence, nil
+}
+
+func (j journey) Journey() *model.Journey {
+	journey := &model.Journey{
+		ID:        j.ID,
+		User:      &model.User{ID: j.UserId},
+		Status:    model.JourneyStatus(j.Status),
+		Position:  j.Position(),
+		Version:   j.Version,
+		Stats:     j.Stats(),
+		CreatedAt: j.CreatedAt,
+		UpdatedAt: j.UpdatedAt,
+	}
+	if j.DestinationLat.Valid && j.DestinationLng.Valid {
+		journey.Destination = &model.Coordinates{Lat: j.DestinationLat.Float64, Lng: j.DestinationLng.Float64}
+	}
+	journey.RemainingDistance = nullableFloat(j.RemainingDistance)
+	if j.Eta.Valid {
+		eta := j.Eta.Time
+		journey.Eta = &eta
+	}
+	if j.CompletedAt.Valid {
+		completedAt := j.CompletedAt.Time
+		journey.CompletedAt = &completedAt
+	}
+
+	return journey
+}
+
+func (j journey) Stats() *model.JourneyStats {
+	s := &model.JourneyStats{
+		DistanceMeters: j.DistanceMeters,
+		MaxSpeed:       j.MaxSpeed,
+	}
+	if j.StartedAt.Valid {
+		startedAt := j.StartedAt.Time
+		s.StartedAt = &startedAt
+	}
+	if j.EndedAt.Valid {
+		endedAt := j.EndedAt.Time
+		s.EndedAt = &endedAt
+	}
+
+	return stats.Derive(s)
+}
+
 func NewPostgres(url url.URL, migrationsUrl string) (*Client, error) {
 	db, err := sqlx.Connect("postgres", url.String())
 	if err != nil {
@@ -61,49 +308,161 @@ func NewPostgres(url url.URL, migrationsUrl string) (*Client, error) {
 }
 
 func (c Client) GetJourney(ctx context.Context, id string) (*model.Journey, error) {
-	query, args, err := sq.
-		Select("id", "user_id", "status", "lat", "lng").
+	return c.getJourney(ctx, sq.
+		Select(journeyColumns...).
+		From("journeys").
+		Where(sq.Eq{"id": id}))
+}
+
+// GetJourneyForUpdate returns the journey, locking its row until the current transaction ends.
+func (c Client) GetJourneyForUpdate(ctx context.Context, id string) (*model.Journey, error) {
+	return c.getJourney(ctx, sq.
+		Select(journeyColumns...).
 		From("journeys").
 		Where(sq.Eq{"id": id}).
-		PlaceholderFormat(sq.Dollar).
-		ToSql()
+		Suffix("FOR UPDATE"))
+}
+
+func (c Client) getJourney(ctx context.Context, builder sq.SelectBuilder) (*model.Journey, error) {
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("to sql : %w", err)
 	}
 
 	var j = &journey{}
-	if err := c.db.GetContext(ctx, j, query, args...); err != nil {
+	if err := sqlx.GetContext(ctx, c.ext(ctx), j, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
 		return nil, fmt.Errorf("get : %w", err)
 	}
 
-	return &model.Journey{
-		ID:       j.ID,
-		User:     &model.User{ID: j.UserId},
-		Status:   model.JourneyStatus(j.Status),
-		Position: j.Position(),
-	}, nil
+	return j.Journey(), nil
+}
+
+// ListJourneys returns up to limit journeys belonging to the user ordered by when they were created, newest first,
+// starting after the given cursor.
+func (c Client) ListJourneys(ctx context.Context, userID string, status *model.JourneyStatus, after *repositories.JourneyCursor, limit uint64) ([]*model.Journey, error) {
+	builder := sq.
+		Select(journeyColumns...).
+		From("journeys").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(limit)
+	if status != nil {
+		builder = builder.Where(sq.Eq{"status": *status})
+	}
+	if after != nil {
+		builder = builder.Where(sq.Expr("(created_at, id) < (?, ?)", after.CreatedAt, after.ID))
+	}
+
+	return c.listJourneys(ctx, builder)
+}
+
+// GetActiveJourney returns the user's most recently updated active journey, or repositories.ErrNotFound if they have
+// none.
+func (c Client) GetActiveJourney(ctx context.Context, userID string) (*model.Journey, error) {
+	journeys, err := c.listJourneys(ctx, sq.
+		Select(journeyColumns...).
+		From("journeys").
+		Where(sq.Eq{"user_id": userID, "status": model.JourneyStatusActive}).
+		OrderBy("updated_at DESC").
+		Limit(1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(journeys) == 0 {
+		return nil, repositories.ErrNotFound
+	}
+
+	return journeys[0], nil
+}
+
+func (c Client) listJourneys(ctx context.Context, builder sq.SelectBuilder) ([]*model.Journey, error) {
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql : %w", err)
+	}
+
+	var js []journey
+	if err := sqlx.SelectContext(ctx, c.ext(ctx), &js, query, args...); err != nil {
+		return nil, fmt.Errorf("select : %w", err)
+	}
+
+	journeys := make([]*model.Journey, 0, len(js))
+	for _, j := range js {
+		journeys = append(journeys, j.Journey())
+	}
+
+	return journeys, nil
+}
+
+// ListIdleJourneys returns up to limit active journeys last updated before the given time, least recently updated
+// first.
+func (c Client) ListIdleJourneys(ctx context.Context, before time.Time, limit uint64) ([]*model.Journey, error) {
+	query, args, err := sq.
+		Select(journeyColumns...).
+		From("journeys").
+		Where(sq.Eq{"status": model.JourneyStatusActive}).
+		Where(sq.Lt{"updated_at": before}).
+		OrderBy("updated_at").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql : %w", err)
+	}
+
+	var js []journey
+	if err := sqlx.SelectContext(ctx, c.ext(ctx), &js, query, args...); err != nil {
+		return nil, fmt.Errorf("select : %w", err)
+	}
+
+	journeys := make([]*model.Journey, 0, len(js))
+	for _, j := range js {
+		journeys = append(journeys, j.Journey())
+	}
+
+	return journeys, nil
 }
 
 func (c Client) CreateJourney(ctx context.Context, journey *model.Journey) error {
+	var destinationLat, destinationLng sql.NullFloat64
+	if journey.Destination != nil {
+		destinationLat = sql.NullFloat64{Float64: journey.Destination.Lat, Valid: true}
+		destinationLng = sql.NullFloat64{Float64: journey.Destination.Lng, Valid: true}
+	}
 	query, args, err := sq.
 		Insert("journeys").
-		Columns("id", "user_id", "status").
-		Values(journey.ID, journey.User.ID, journey.Status).
+		Columns("id", "user_id", "status", "version", "destination_lat", "destination_lng", "created_at",
+			"completed_at", "updated_at").
+		Values(journey.ID, journey.User.ID, journey.Status, journey.Version, destinationLat, destinationLng,
+			journey.CreatedAt, journey.CompletedAt, journey.UpdatedAt).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("to sql : %w", err)
 	}
 
-	if _, err := c.db.ExecContext(ctx, query, args...); err != nil {
-		return fmt.Errorf("exec context : %w", err)
-	}
+	return c.Transaction(ctx, func(ctx context.Context) error {
+		if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("exec context : %w", err)
+		}
 
-	return nil
+		if journey.Stats != nil {
+			return c.UpdateStats(ctx, journey.ID, journey.Stats)
+		}
+
+		return nil
+	})
 }
 
 func (c Client) UpdatePosition(ctx context.Context, id string, position *model.Position) error {
-	var lat, lng sql.NullFloat64
+	var (
+		lat, lng, accuracy, altitude, speed, heading sql.NullFloat64
+		recordedAt                                   sql.NullTime
+	)
 	if position != nil {
 		lat = sql.NullFloat64{
 			Float64: position.Lat,
@@ -113,11 +472,24 @@ func (c Client) UpdatePosition(ctx context.Context, id string, position *model.P
 			Float64: position.Lng,
 			Valid:   true,
 		}
+		accuracy = nullFloat(position.Accuracy)
+		altitude = nullFloat(position.Altitude)
+		speed = nullFloat(position.Speed)
+		heading = nullFloat(position.Heading)
+		if position.RecordedAt != nil {
+			recordedAt = sql.NullTime{Time: *position.RecordedAt, Valid: true}
+		}
 	}
 	query, args, err := sq.
 		Update("journeys").
 		Set("lat", lat).
 		Set("lng", lng).
+		Set("accuracy", accuracy).
+		Set("altitude", altitude).
+		Set("speed", speed).
+		Set("heading", heading).
+		Set("position_recorded_at", recordedAt).
+		Set("updated_at", sq.Expr("now()")).
 		Where(sq.Eq{"id": id}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
@@ -125,17 +497,268 @@ func (c Client) UpdatePosition(ctx context.Context, id string, position *model.P
 		return fmt.Errorf("to sql : %w", err)
 	}
 
-	if _, err := c.db.ExecContext(ctx, query, args...); err != nil {
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
 		return fmt.Errorf("exec context : %w", err)
 	}
 
 	return nil
 }
 
-func (c Client) UpdateStatus(ctx context.Context, id string, status model.JourneyStatus) error {
+// AddPosition sets the current position of the journey and appends it to the journey's track. The position is
+// recorded now unless it has a recorded time.
+func (c Client) AddPosition(ctx context.Context, id string, position *model.Position) error {
+	recordedAt := time.Now().UTC()
+	if position.RecordedAt != nil {
+		recordedAt = *position.RecordedAt
+	}
+
+	insert, insertArgs, err := sq.
+		Insert("positions").
+		Columns("journey_id", "seq", "lat", "lng", "accuracy", "altitude", "speed", "heading", "recorded_at").
+		Values(id, sq.Expr("(SELECT COALESCE(MAX(seq), 0) + 1 FROM positions WHERE journey_id = ?)", id),
+			position.Lat, position.Lng, position.Accuracy, position.Altitude, position.Speed, position.Heading,
+			recordedAt).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	p := *position
+	p.RecordedAt = &recordedAt
+
+	return c.Transaction(ctx, func(ctx context.Context) error {
+		// updating the journey first locks its row so concurrent appends cannot share a sequence number
+		if err := c.UpdatePosition(ctx, id, &p); err != nil {
+			return err
+		}
+
+		if _, err := c.ext(ctx).ExecContext(ctx, insert, insertArgs...); err != nil {
+			return fmt.Errorf("exec context : %w", err)
+		}
+
+		return nil
+	})
+}
+
+// AddPositions appends the points to the journey's track in batches, locking the journey's row so concurrent appends
+// cannot share a sequence number.
+func (c Client) AddPositions(ctx context.Context, id string, points []*model.TrackPoint) error {
+	lock, lockArgs, err := sq.
+		Select("id").
+		From("journeys").
+		Where(sq.Eq{"id": id}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	last, lastArgs, err := sq.
+		Select("COALESCE(MAX(seq), 0)").
+		From("positions").
+		Where(sq.Eq{"journey_id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	return c.Transaction(ctx, func(ctx context.Context) error {
+		var journeyID string
+		if err := sqlx.GetContext(ctx, c.ext(ctx), &journeyID, lock, lockArgs...); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return repositories.ErrNotFound
+			}
+			return fmt.Errorf("get : %w", err)
+		}
+
+		var seq int
+		if err := sqlx.GetContext(ctx, c.ext(ctx), &seq, last, lastArgs...); err != nil {
+			return fmt.Errorf("get : %w", err)
+		}
+
+		for start := 0; start < len(points); start += insertBatchSize {
+			end := start + insertBatchSize
+			if end > len(points) {
+				end = len(points)
+			}
+
+			builder := sq.
+				Insert("positions").
+				Columns("journey_id", "seq", "lat", "lng", "accuracy", "altitude", "speed", "heading", "recorded_at").
+				PlaceholderFormat(sq.Dollar)
+			for _, p := range points[start:end] {
+				seq++
+				builder = builder.Values(id, seq, p.Lat, p.Lng, p.Accuracy, p.Altitude, p.Speed, p.Heading,
+					p.RecordedAt)
+			}
+
+			query, args, err := builder.ToSql()
+			if err != nil {
+				return fmt.Errorf("to sql : %w", err)
+			}
+
+			if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+				return fmt.Errorf("exec context : %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// ListPositions returns up to limit positions of the journey's track in recorded order, starting after the given
+// sequence number and excluding positions recorded before since.
+func (c Client) ListPositions(ctx context.Context, id string, since *time.Time, after *int, limit uint64) ([]*model.TrackPoint, error) {
+	builder := sq.
+		Select(positionColumns...).
+		From("positions").
+		Where(sq.Eq{"journey_id": id}).
+		OrderBy("seq").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar)
+	if since != nil {
+		builder = builder.Where(sq.GtOrEq{"recorded_at": *since})
+	}
+	if after != nil {
+		builder = builder.Where(sq.Gt{"seq": *after})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql : %w", err)
+	}
+
+	var ps []position
+	if err := sqlx.SelectContext(ctx, c.ext(ctx), &ps, query, args...); err != nil {
+		return nil, fmt.Errorf("select : %w", err)
+	}
+
+	points := make([]*model.TrackPoint, 0, len(ps))
+	for _, p := range ps {
+		points = append(points, p.TrackPoint())
+	}
+
+	return points, nil
+}
+
+// ListRecentPositions returns the latest limit positions of the journey's track recorded since the given time, in
+// recorded order.
+func (c Client) ListRecentPositions(ctx context.Context, id string, since time.Time, limit uint64) ([]*model.TrackPoint, error) {
+	query, args, err := sq.
+		Select(positionColumns...).
+		From("positions").
+		Where(sq.Eq{"journey_id": id}).
+		Where(sq.GtOrEq{"recorded_at": since}).
+		OrderBy("seq DESC").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql : %w", err)
+	}
+
+	var ps []position
+	if err := sqlx.SelectContext(ctx, c.ext(ctx), &ps, query, args...); err != nil {
+		return nil, fmt.Errorf("select : %w", err)
+	}
+
+	points := make([]*model.TrackPoint, len(ps))
+	for i, p := range ps {
+		points[len(ps)-1-i] = p.TrackPoint()
+	}
+
+	return points, nil
+}
+
+func (c Client) StreamPositions(ctx context.Context, id string, fn func(point *model.TrackPoint) error) error {
 	query, args, err := sq.
+		Select(positionColumns...).
+		From("positions").
+		Where(sq.Eq{"journey_id": id}).
+		OrderBy("seq").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	rows, err := c.ext(ctx).QueryxContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("query : %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var p position
+		if err := rows.StructScan(&p); err != nil {
+			return fmt.Errorf("struct scan : %w", err)
+		}
+
+		if err := fn(p.TrackPoint()); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows : %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus sets the status of the journey, recording when it was completed if the status is final.
+func (c Client) UpdateStatus(ctx context.Context, id string, status model.JourneyStatus) error {
+	builder := sq.
 		Update("journeys").
 		Set("status", status).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar)
+	if lifecycle.Final(status) {
+		builder = builder.Set("completed_at", sq.Expr("now()"))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) UpdateStats(ctx context.Context, id string, stats *model.JourneyStats) error {
+	query, args, err := sq.
+		Update("journeys").
+		Set("distance_meters", stats.DistanceMeters).
+		Set("max_speed", stats.MaxSpeed).
+		Set("started_at", stats.StartedAt).
+		Set("ended_at", stats.EndedAt).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) UpdateETA(ctx context.Context, id string, remainingDistance *float64, eta *time.Time) error {
+	query, args, err := sq.
+		Update("journeys").
+		Set("remaining_distance", nullFloat(remainingDistance)).
+		Set("eta", eta).
 		Where(sq.Eq{"id": id}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
@@ -143,9 +766,853 @@ func (c Client) UpdateStatus(ctx context.Context, id string, status model.Journe
 		return fmt.Errorf("to sql : %w", err)
 	}
 
-	if _, err := c.db.ExecContext(ctx, query, args...); err != nil {
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
 		return fmt.Errorf("exec context : %w", err)
 	}
 
 	return nil
 }
+
+// AddViewer allows the user to view the journey, it is not an error if they already can.
+func (c Client) AddViewer(ctx context.Context, journeyID, userID string) error {
+	query, args, err := sq.
+		Insert("journey_viewers").
+		Columns("journey_id", "user_id").
+		Values(journeyID, userID).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) RemoveViewer(ctx context.Context, journeyID, userID string) error {
+	query, args, err := sq.
+		Delete("journey_viewers").
+		Where(sq.Eq{"journey_id": journeyID, "user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) IsViewer(ctx context.Context, journeyID, userID string) (bool, error) {
+	query, args, err := sq.
+		Select("1").
+		Prefix("SELECT EXISTS (").
+		From("journey_viewers").
+		Where(sq.Eq{"journey_id": journeyID, "user_id": userID}).
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("to sql : %w", err)
+	}
+
+	var exists bool
+	if err := sqlx.GetContext(ctx, c.ext(ctx), &exists, query, args...); err != nil {
+		return false, fmt.Errorf("get : %w", err)
+	}
+
+	return exists, nil
+}
+
+func (c Client) ListViewers(ctx context.Context, journeyID string) ([]string, error) {
+	query, args, err := sq.
+		Select("user_id").
+		From("journey_viewers").
+		Where(sq.Eq{"journey_id": journeyID}).
+		OrderBy("user_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql : %w", err)
+	}
+
+	viewers := []string{}
+	if err := sqlx.SelectContext(ctx, c.ext(ctx), &viewers, query, args...); err != nil {
+		return nil, fmt.Errorf("select : %w", err)
+	}
+
+	return viewers, nil
+}
+
+func (c Client) CreateShareLink(ctx context.Context, link *model.ShareLink) error {
+	query, args, err := sq.
+		Insert("share_links").
+		Columns("id", "journey_id", "expires_at").
+		Values(link.ID, link.JourneyID, link.ExpiresAt).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) GetShareLink(ctx context.Context, id string) (*model.ShareLink, error) {
+	query, args, err := sq.
+		Select("id", "journey_id", "expires_at", "revoked_at").
+		From("share_links").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql : %w", err)
+	}
+
+	var l = &shareLink{}
+	if err := sqlx.GetContext(ctx, c.ext(ctx), l, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("get : %w", err)
+	}
+
+	return &model.ShareLink{
+		ID:        l.ID,
+		JourneyID: l.JourneyID,
+		ExpiresAt: l.ExpiresAt,
+		Revoked:   l.RevokedAt.Valid,
+	}, nil
+}
+
+func (c Client) RevokeShareLink(ctx context.Context, id string) error {
+	query, args, err := sq.
+		Update("share_links").
+		Set("revoked_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id, "revoked_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) AddJourneyLogEvent(ctx context.Context, event *model.JourneyLogEvent) error {
+	data := journeyLogData{
+		PreviousStatus: event.PreviousStatus,
+		Status:         event.Status,
+		Event:          event.Event,
+		Position:       event.Position,
+		ShareLinkID:    event.ShareLinkID,
+	}
+	if event.Viewer != nil {
+		data.ViewerID = &event.Viewer.ID
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal : %w", err)
+	}
+
+	var actorID sql.NullString
+	if event.Actor != nil {
+		actorID = sql.NullString{String: event.Actor.ID, Valid: true}
+	}
+
+	query, args, err := sq.
+		Insert("journey_events").
+		Columns("journey_id", "action", "actor_id", "data", "created_at").
+		Values(event.JourneyID, event.Action, actorID, payload, event.CreatedAt).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if err := sqlx.GetContext(ctx, c.ext(ctx), &event.Seq, query, args...); err != nil {
+		return fmt.Errorf("get : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) ListJourneyLogEvents(ctx context.Context, journeyID string, after *int64, limit uint64) ([]*model.JourneyLogEvent, error) {
+	builder := sq.
+		Select("id", "journey_id", "action", "actor_id", "data", "created_at").
+		From("journey_events").
+		Where(sq.Eq{"journey_id": journeyID}).
+		OrderBy("id").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar)
+	if after != nil {
+		builder = builder.Where(sq.Gt{"id": *after})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql : %w", err)
+	}
+
+	var es []journeyLogEvent
+	if err := sqlx.SelectContext(ctx, c.ext(ctx), &es, query, args...); err != nil {
+		return nil, fmt.Errorf("select : %w", err)
+	}
+
+	events := make([]*model.JourneyLogEvent, 0, len(es))
+	for _, e := range es {
+		event, err := e.JourneyLogEvent()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// IncrementVersion increments the version of the journey, returning the new version.
+func (c Client) IncrementVersion(ctx context.Context, id string) (int, error) {
+	query, args, err := sq.
+		Update("journeys").
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id}).
+		Suffix("RETURNING version").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql : %w", err)
+	}
+
+	var version int
+	if err := sqlx.GetContext(ctx, c.ext(ctx), &version, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, repositories.ErrNotFound
+		}
+		return 0, fmt.Errorf("get : %w", err)
+	}
+
+	return version, nil
+}
+
+// ListJourneyVersions returns up to limit versions of the journey recorded in the outbox after the given version,
+// oldest first.
+func (c Client) ListJourneyVersions(ctx context.Context, id string, since int, limit uint64) ([]*model.Journey, error) {
+	query, args, err := sq.
+		Select("payload").
+		From("outbox").
+		Where(sq.Eq{"journey_id": id, "kind": outboxKindJourney}).
+		Where(sq.Gt{"version": since}).
+		OrderBy("version").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql : %w", err)
+	}
+
+	var payloads [][]byte
+	if err := sqlx.SelectContext(ctx, c.ext(ctx), &payloads, query, args...); err != nil {
+		return nil, fmt.Errorf("select : %w", err)
+	}
+
+	journeys := make([]*model.Journey, 0, len(payloads))
+	for _, payload := range payloads {
+		var journey = &model.Journey{}
+		if err := json.Unmarshal(payload, journey); err != nil {
+			return nil, fmt.Errorf("unmarshal : %w", err)
+		}
+		journeys = append(journeys, journey)
+	}
+
+	return journeys, nil
+}
+
+func (c Client) AddOutboxEvent(ctx context.Context, journey *model.Journey) error {
+	payload, err := json.Marshal(journey)
+	if err != nil {
+		return fmt.Errorf("marshal : %w", err)
+	}
+
+	query, args, err := sq.
+		Insert("outbox").
+		Columns("journey_id", "version", "payload").
+		Values(journey.ID, journey.Version, payload).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) AddGeofenceOutboxEvent(ctx context.Context, crossing *model.GeofenceCrossing) error {
+	payload, err := json.Marshal(crossing)
+	if err != nil {
+		return fmt.Errorf("marshal : %w", err)
+	}
+
+	query, args, err := sq.
+		Insert("outbox").
+		Columns("journey_id", "kind", "payload").
+		Values(crossing.JourneyID, outboxKindGeofenceCrossing, payload).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) ClaimOutboxEvents(ctx context.Context, limit uint64) ([]*repositories.OutboxEvent, error) {
+	query, args, err := sq.
+		Select("id", "kind", "payload", "attempts").
+		From("outbox").
+		Where(sq.Eq{"delivered_at": nil, "failed_at": nil}).
+		Where(sq.Expr("available_at <= now()")).
+		OrderBy("id").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql : %w", err)
+	}
+
+	var es []outboxEvent
+	if err := sqlx.SelectContext(ctx, c.ext(ctx), &es, query, args...); err != nil {
+		return nil, fmt.Errorf("select : %w", err)
+	}
+
+	events := make([]*repositories.OutboxEvent, 0, len(es))
+	for _, e := range es {
+		event := &repositories.OutboxEvent{
+			ID:       e.ID,
+			Attempts: e.Attempts,
+		}
+
+		var err error
+		switch e.Kind {
+		case outboxKindGeofenceCrossing:
+			event.GeofenceCrossing = &model.GeofenceCrossing{}
+			err = json.Unmarshal(e.Payload, event.GeofenceCrossing)
+		default:
+			event.Journey = &model.Journey{}
+			err = json.Unmarshal(e.Payload, event.Journey)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal : %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (c Client) MarkOutboxEventDelivered(ctx context.Context, id int64) error {
+	query, args, err := sq.
+		Update("outbox").
+		Set("delivered_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) RetryOutboxEvent(ctx context.Context, id int64, at time.Time) error {
+	query, args, err := sq.
+		Update("outbox").
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("available_at", at).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) RescheduleOutboxEvent(ctx context.Context, id int64, at time.Time) error {
+	query, args, err := sq.
+		Update("outbox").
+		Set("available_at", at).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) FailOutboxEvent(ctx context.Context, id int64) error {
+	query, args, err := sq.
+		Update("outbox").
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("failed_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+// CreateGeofence stores the geofence, with the vertices of a polygon fence as JSON.
+func (c Client) CreateGeofence(ctx context.Context, fence *model.Geofence) error {
+	g, err := newGeofence(fence)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := sq.
+		Insert("geofences").
+		Columns("id", "user_id", "name", "shape", "center_lat", "center_lng", "radius", "polygon").
+		Values(g.ID, g.UserID, g.Name, g.Shape, g.CenterLat, g.CenterLng, g.Radius, g.Polygon).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) GetGeofence(ctx context.Context, id string) (*model.Geofence, error) {
+	query, args, err := sq.
+		Select("id", "user_id", "name", "shape", "center_lat", "center_lng", "radius", "polygon").
+		From("geofences").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql : %w", err)
+	}
+
+	var g geofence
+	if err := sqlx.GetContext(ctx, c.ext(ctx), &g, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("get : %w", err)
+	}
+
+	return g.Geofence()
+}
+
+func (c Client) ListGeofences(ctx context.Context, userIDs []string) ([]*model.Geofence, error) {
+	query, args, err := sq.
+		Select("id", "user_id", "name", "shape", "center_lat", "center_lng", "radius", "polygon").
+		From("geofences").
+		Where(sq.Eq{"user_id": userIDs}).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql : %w", err)
+	}
+
+	var gs []geofence
+	if err := sqlx.SelectContext(ctx, c.ext(ctx), &gs, query, args...); err != nil {
+		return nil, fmt.Errorf("select : %w", err)
+	}
+
+	fences := make([]*model.Geofence, 0, len(gs))
+	for _, g := range gs {
+		fence, err := g.Geofence()
+		if err != nil {
+			return nil, err
+		}
+		fences = append(fences, fence)
+	}
+
+	return fences, nil
+}
+
+func (c Client) UpdateGeofence(ctx context.Context, fence *model.Geofence) error {
+	g, err := newGeofence(fence)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := sq.
+		Update("geofences").
+		Set("name", g.Name).
+		Set("shape", g.Shape).
+		Set("center_lat", g.CenterLat).
+		Set("center_lng", g.CenterLng).
+		Set("radius", g.Radius).
+		Set("polygon", g.Polygon).
+		Where(sq.Eq{"id": g.ID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) DeleteGeofence(ctx context.Context, id string) error {
+	query, args, err := sq.
+		Delete("geofences").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("to sql : %w", err)
+	}
+
+	if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) CountJourneysUpdatedBefore(ctx context.Context, status model.JourneyStatus, before time.Time) (int64, error) {
+	query, args, err := sq.
+		Select("count(*)").
+		From("journeys").
+		Where(sq.Eq{"status": status}).
+		Where(sq.Lt{"updated_at": before}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql : %w", err)
+	}
+
+	var n int64
+	if err := sqlx.GetContext(ctx, c.ext(ctx), &n, query, args...); err != nil {
+		return 0, fmt.Errorf("get : %w", err)
+	}
+
+	return n, nil
+}
+
+// DeleteJourneysUpdatedBefore deletes up to limit journeys in the status last updated before the given time, least
+// recently updated first. Positions, logs and shares are deleted with their journey, outbox events are deleted
+// explicitly as they do not reference it. Journeys locked by another transaction are skipped.
+func (c Client) DeleteJourneysUpdatedBefore(ctx context.Context, status model.JourneyStatus, before time.Time, limit uint64) (int64, error) {
+	lock, lockArgs, err := sq.
+		Select("id").
+		From("journeys").
+		Where(sq.Eq{"status": status}).
+		Where(sq.Lt{"updated_at": before}).
+		OrderBy("updated_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql : %w", err)
+	}
+
+	var deleted int64
+	err = c.Transaction(ctx, func(ctx context.Context) error {
+		var ids []string
+		if err := sqlx.SelectContext(ctx, c.ext(ctx), &ids, lock, lockArgs...); err != nil {
+			return fmt.Errorf("select : %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		for _, table := range []struct{ name, column string }{{"outbox", "journey_id"}, {"journeys", "id"}} {
+			query, args, err := sq.
+				Delete(table.name).
+				Where(sq.Eq{table.column: ids}).
+				PlaceholderFormat(sq.Dollar).
+				ToSql()
+			if err != nil {
+				return fmt.Errorf("to sql : %w", err)
+			}
+
+			if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+				return fmt.Errorf("exec context : %w", err)
+			}
+		}
+		deleted = int64(len(ids))
+
+		return nil
+	})
+
+	return deleted, err
+}
+
+// excessPositions selects the positions that down-sampling the tracks of the journeys matching the condition to the
+// interval removes.
+func excessPositions(journeys sq.Sqlizer, interval time.Duration) sq.SelectBuilder {
+	if interval <= 0 {
+		return sq.
+			Select("journey_id", "seq").
+			From("positions").
+			Where(journeys)
+	}
+
+	ranked := sq.
+		Select("journey_id", "seq").
+		Column(sq.Expr("row_number() OVER (PARTITION BY journey_id, floor(extract(EPOCH FROM recorded_at) / ?) "+
+			"ORDER BY seq) AS bucket_rank", interval.Seconds())).
+		Column("seq = max(seq) OVER (PARTITION BY journey_id) AS last").
+		From("positions").
+		Where(journeys)
+
+	return sq.
+		Select("journey_id", "seq").
+		FromSelect(ranked, "ranked").
+		Where("bucket_rank > 1 AND NOT last")
+}
+
+// undownsampledJourneys selects the journeys completed before the given time whose tracks have not been down-sampled.
+func undownsampledJourneys(before time.Time) sq.SelectBuilder {
+	return sq.
+		Select("id").
+		From("journeys").
+		Where(sq.Lt{"completed_at": before}).
+		Where(sq.Eq{"downsampled_at": nil})
+}
+
+func (c Client) CountDownsamplePositions(ctx context.Context, before time.Time, interval time.Duration) (int64, error) {
+	journeys := sq.Expr("journey_id IN (?)", undownsampledJourneys(before))
+	query, args, err := sq.
+		Select("count(*)").
+		FromSelect(excessPositions(journeys, interval), "positions").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql : %w", err)
+	}
+
+	var n int64
+	if err := sqlx.GetContext(ctx, c.ext(ctx), &n, query, args...); err != nil {
+		return 0, fmt.Errorf("get : %w", err)
+	}
+
+	return n, nil
+}
+
+// DownsampleJourneys down-samples the tracks of up to limit journeys completed before the given time, least recently
+// completed first, and strips positions from their logs and outbox. Each journey is marked once it is down-sampled so
+// it is not read again. Journeys locked by another transaction are skipped.
+func (c Client) DownsampleJourneys(ctx context.Context, before time.Time, interval time.Duration, limit uint64) (int64, int64, error) {
+	lock, lockArgs, err := undownsampledJourneys(before).
+		OrderBy("completed_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, 0, fmt.Errorf("to sql : %w", err)
+	}
+
+	var downsampled, deleted int64
+	err = c.Transaction(ctx, func(ctx context.Context) error {
+		var ids []string
+		if err := sqlx.SelectContext(ctx, c.ext(ctx), &ids, lock, lockArgs...); err != nil {
+			return fmt.Errorf("select : %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		query, args, err := sq.
+			Delete("positions").
+			Where(sq.Expr("(journey_id, seq) IN (?)", excessPositions(sq.Eq{"journey_id": ids}, interval))).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("to sql : %w", err)
+		}
+
+		result, err := c.ext(ctx).ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("exec context : %w", err)
+		}
+		if deleted, err = result.RowsAffected(); err != nil {
+			return fmt.Errorf("rows affected : %w", err)
+		}
+
+		for _, statement := range []sq.Sqlizer{
+			sq.Update("journey_events").
+				Set("data", sq.Expr("data - 'position'")).
+				Where(sq.Eq{"journey_id": ids}).
+				Where("data -> 'position' IS NOT NULL").
+				PlaceholderFormat(sq.Dollar),
+			sq.Delete("outbox").
+				Where(sq.Eq{"journey_id": ids}).
+				PlaceholderFormat(sq.Dollar),
+			sq.Update("journeys").
+				Set("downsampled_at", sq.Expr("now()")).
+				Set("version", sq.Expr("version + 1")).
+				Where(sq.Eq{"id": ids}).
+				PlaceholderFormat(sq.Dollar),
+		} {
+			query, args, err := statement.ToSql()
+			if err != nil {
+				return fmt.Errorf("to sql : %w", err)
+			}
+
+			if _, err := c.ext(ctx).ExecContext(ctx, query, args...); err != nil {
+				return fmt.Errorf("exec context : %w", err)
+			}
+		}
+		downsampled = int64(len(ids))
+
+		return nil
+	})
+
+	return downsampled, deleted, err
+}
+
+func (c Client) CountOutboxEventsSettledBefore(ctx context.Context, before time.Time) (int64, error) {
+	query, args, err := sq.
+		Select("count(*)").
+		From("outbox").
+		Where(sq.Or{sq.Lt{"delivered_at": before}, sq.Lt{"failed_at": before}}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql : %w", err)
+	}
+
+	var n int64
+	if err := sqlx.GetContext(ctx, c.ext(ctx), &n, query, args...); err != nil {
+		return 0, fmt.Errorf("get : %w", err)
+	}
+
+	return n, nil
+}
+
+func (c Client) DeleteOutboxEventsSettledBefore(ctx context.Context, before time.Time, limit uint64) (int64, error) {
+	query, args, err := sq.
+		Delete("outbox").
+		Where(sq.Expr("id IN (?)", sq.
+			Select("id").
+			From("outbox").
+			Where(sq.Or{sq.Lt{"delivered_at": before}, sq.Lt{"failed_at": before}}).
+			OrderBy("id").
+			Limit(limit))).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql : %w", err)
+	}
+
+	result, err := c.ext(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("exec context : %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected : %w", err)
+	}
+
+	return n, nil
+}
+
+// Notify sends a notification to listeners of the channel once the current transaction, if any, commits.
+func (c Client) Notify(ctx context.Context, channel, payload string) error {
+	if _, err := c.ext(ctx).ExecContext(ctx, "SELECT pg_notify($1, $2)", channel, payload); err != nil {
+		return fmt.Errorf("exec context : %w", err)
+	}
+
+	return nil
+}
+
+type txKey struct{}
+
+// Transaction runs fn in a database transaction. Repository calls made with the context given to fn are part of
+// the transaction, and calls to Transaction within fn join it rather than starting their own.
+func (c Client) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin : %w", err)
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback : %v : %w", rbErr, err)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit : %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) ext(ctx context.Context) sqlx.ExtContext {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+
+	return c.db
+}
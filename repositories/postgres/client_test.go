@@ -0,0 +1,28 @@
+package postgres
+
+import (
+	"github.com/cobbinma/track-api/repositories/repositorytest"
+	"net/url"
+	"os"
+	"testing"
+)
+
+// TestClient runs against the database in TEST_DATABASE_URL, migrating it up first. It is skipped when none is given.
+func TestClient(t *testing.T) {
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL not given")
+	}
+
+	dbu, err := url.Parse(databaseURL)
+	if err != nil {
+		t.Fatalf("unable to parse TEST_DATABASE_URL: %v", err)
+	}
+
+	client, err := NewPostgres(*dbu, "file://migrations")
+	if err != nil {
+		t.Fatalf("unable to connect to postgres: %v", err)
+	}
+
+	repositorytest.Run(t, client)
+}
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS positions;
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS positions  (
+    journey_id uuid NOT NULL REFERENCES journeys (id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    lat FLOAT NOT NULL,
+    lng FLOAT NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (journey_id, seq)
+);
+
+INSERT INTO positions (journey_id, seq, lat, lng)
+SELECT id, 1, lat, lng FROM journeys WHERE lat IS NOT NULL AND lng IS NOT NULL;
\ No newline at end of file
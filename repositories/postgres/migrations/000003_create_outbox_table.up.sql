@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS outbox  (
+    id BIGSERIAL PRIMARY KEY,
+    journey_id uuid NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    available_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    delivered_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_pending_idx ON outbox (id) WHERE delivered_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS outbox_journey_version_idx;
+
+ALTER TABLE outbox DROP COLUMN IF EXISTS version;
+
+ALTER TABLE journeys DROP COLUMN IF EXISTS version;
\ No newline at end of file
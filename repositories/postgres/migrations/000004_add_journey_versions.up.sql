@@ -0,0 +1,5 @@
+ALTER TABLE journeys ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS outbox_journey_version_idx ON outbox (journey_id, version);
\ No newline at end of file
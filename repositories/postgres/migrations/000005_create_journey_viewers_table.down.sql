@@ -0,0 +1 @@
+DROP TABLE IF EXISTS journey_viewers;
\ No newline at end of file
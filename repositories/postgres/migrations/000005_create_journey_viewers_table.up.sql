@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS journey_viewers  (
+    journey_id uuid NOT NULL REFERENCES journeys (id) ON DELETE CASCADE,
+    user_id VARCHAR (50) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (journey_id, user_id)
+);
\ No newline at end of file
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS share_links  (
+    id uuid UNIQUE PRIMARY KEY,
+    journey_id uuid NOT NULL REFERENCES journeys (id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
\ No newline at end of file
@@ -0,0 +1,12 @@
+ALTER TABLE positions
+    DROP COLUMN IF EXISTS heading,
+    DROP COLUMN IF EXISTS speed,
+    DROP COLUMN IF EXISTS altitude,
+    DROP COLUMN IF EXISTS accuracy;
+
+ALTER TABLE journeys
+    DROP COLUMN IF EXISTS position_recorded_at,
+    DROP COLUMN IF EXISTS heading,
+    DROP COLUMN IF EXISTS speed,
+    DROP COLUMN IF EXISTS altitude,
+    DROP COLUMN IF EXISTS accuracy;
\ No newline at end of file
@@ -0,0 +1,12 @@
+ALTER TABLE journeys
+    ADD COLUMN IF NOT EXISTS accuracy FLOAT,
+    ADD COLUMN IF NOT EXISTS altitude FLOAT,
+    ADD COLUMN IF NOT EXISTS speed FLOAT,
+    ADD COLUMN IF NOT EXISTS heading FLOAT,
+    ADD COLUMN IF NOT EXISTS position_recorded_at TIMESTAMPTZ;
+
+ALTER TABLE positions
+    ADD COLUMN IF NOT EXISTS accuracy FLOAT,
+    ADD COLUMN IF NOT EXISTS altitude FLOAT,
+    ADD COLUMN IF NOT EXISTS speed FLOAT,
+    ADD COLUMN IF NOT EXISTS heading FLOAT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE journeys
+    DROP COLUMN IF EXISTS ended_at,
+    DROP COLUMN IF EXISTS started_at,
+    DROP COLUMN IF EXISTS max_speed,
+    DROP COLUMN IF EXISTS distance_meters;
\ No newline at end of file
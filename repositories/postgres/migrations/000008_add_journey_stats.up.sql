@@ -0,0 +1,33 @@
+ALTER TABLE journeys
+    ADD COLUMN IF NOT EXISTS distance_meters FLOAT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS max_speed FLOAT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS started_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS ended_at TIMESTAMPTZ;
+
+WITH segments AS (
+    SELECT journey_id,
+           recorded_at,
+           speed,
+           2 * 6371008.8 * asin(least(1, sqrt(
+                       power(sin(radians(lat - lag(lat) OVER w) / 2), 2) +
+                       cos(radians(lag(lat) OVER w)) * cos(radians(lat)) *
+                       power(sin(radians(lng - lag(lng) OVER w) / 2), 2)))) AS distance,
+           extract(EPOCH FROM recorded_at - lag(recorded_at) OVER w) AS seconds
+    FROM positions
+    WINDOW w AS (PARTITION BY journey_id ORDER BY seq)
+), totals AS (
+    SELECT journey_id,
+           COALESCE(sum(distance), 0) AS distance_meters,
+           COALESCE(max(COALESCE(speed, CASE WHEN seconds > 0 THEN distance / seconds END)), 0) AS max_speed,
+           min(recorded_at) AS started_at,
+           max(recorded_at) AS ended_at
+    FROM segments
+    GROUP BY journey_id
+)
+UPDATE journeys
+SET distance_meters = totals.distance_meters,
+    max_speed = totals.max_speed,
+    started_at = totals.started_at,
+    ended_at = totals.ended_at
+FROM totals
+WHERE journeys.id = totals.journey_id;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE journeys
+    DROP COLUMN IF EXISTS eta,
+    DROP COLUMN IF EXISTS remaining_distance,
+    DROP COLUMN IF EXISTS destination_lng,
+    DROP COLUMN IF EXISTS destination_lat;
\ No newline at end of file
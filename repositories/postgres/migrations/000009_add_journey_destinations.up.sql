@@ -0,0 +1,5 @@
+ALTER TABLE journeys
+    ADD COLUMN IF NOT EXISTS destination_lat FLOAT,
+    ADD COLUMN IF NOT EXISTS destination_lng FLOAT,
+    ADD COLUMN IF NOT EXISTS remaining_distance FLOAT,
+    ADD COLUMN IF NOT EXISTS eta TIMESTAMPTZ;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE outbox DROP COLUMN IF EXISTS kind;
+
+DROP TABLE IF EXISTS geofences;
+
+DROP TYPE IF EXISTS GEOFENCE_SHAPE;
\ No newline at end of file
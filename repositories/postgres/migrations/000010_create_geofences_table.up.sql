@@ -0,0 +1,17 @@
+CREATE TYPE GEOFENCE_SHAPE AS ENUM ('CIRCLE', 'POLYGON');
+
+CREATE TABLE IF NOT EXISTS geofences  (
+    id uuid UNIQUE PRIMARY KEY,
+    user_id VARCHAR (50) NOT NULL,
+    name VARCHAR (100) NOT NULL,
+    shape GEOFENCE_SHAPE NOT NULL,
+    center_lat FLOAT,
+    center_lng FLOAT,
+    radius FLOAT,
+    polygon JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS geofences_user_id_idx ON geofences (user_id);
+
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS kind VARCHAR (20) NOT NULL DEFAULT 'journey';
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TYPE JOURNEY_STATUS ADD VALUE IF NOT EXISTS 'PAUSED';
\ No newline at end of file
@@ -0,0 +1,6 @@
+UPDATE journeys SET status = 'COMPLETE' WHERE status = 'CANCELLED';
+
+ALTER TYPE JOURNEY_STATUS RENAME TO JOURNEY_STATUS_OLD;
+CREATE TYPE JOURNEY_STATUS AS ENUM ('ACTIVE', 'PAUSED', 'COMPLETE');
+ALTER TABLE journeys ALTER COLUMN status TYPE JOURNEY_STATUS USING status::text::JOURNEY_STATUS;
+DROP TYPE JOURNEY_STATUS_OLD;
\ No newline at end of file
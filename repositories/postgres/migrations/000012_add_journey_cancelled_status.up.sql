@@ -0,0 +1 @@
+ALTER TYPE JOURNEY_STATUS ADD VALUE IF NOT EXISTS 'CANCELLED';
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE journeys
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS completed_at,
+    DROP COLUMN IF EXISTS created_at;
\ No newline at end of file
@@ -0,0 +1,15 @@
+ALTER TABLE journeys
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+
+UPDATE journeys
+SET created_at   = COALESCE(started_at, now()),
+    completed_at = CASE WHEN status IN ('COMPLETE', 'CANCELLED') THEN COALESCE(ended_at, now()) END,
+    updated_at   = COALESCE(ended_at, started_at, now());
+
+ALTER TABLE journeys
+    ALTER COLUMN created_at SET DEFAULT now(),
+    ALTER COLUMN created_at SET NOT NULL,
+    ALTER COLUMN updated_at SET DEFAULT now(),
+    ALTER COLUMN updated_at SET NOT NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS journey_events;
\ No newline at end of file
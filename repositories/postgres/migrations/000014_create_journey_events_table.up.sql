@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS journey_events  (
+    id BIGSERIAL PRIMARY KEY,
+    journey_id uuid NOT NULL REFERENCES journeys (id) ON DELETE CASCADE,
+    action VARCHAR (30) NOT NULL,
+    actor_id VARCHAR (50),
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS journey_events_journey_id_idx ON journey_events (journey_id, id);
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS journeys_status_updated_at_idx ON journeys (status, updated_at);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS outbox_delivered_at_idx;
+
+DROP INDEX IF EXISTS journeys_completed_at_idx;
\ No newline at end of file
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS journeys_completed_at_idx ON journeys (completed_at) WHERE completed_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS outbox_delivered_at_idx ON outbox (delivered_at) WHERE delivered_at IS NOT NULL;
\ No newline at end of file
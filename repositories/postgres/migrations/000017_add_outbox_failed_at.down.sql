@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS outbox_failed_at_idx;
+
+DROP INDEX IF EXISTS outbox_pending_idx;
+
+CREATE INDEX IF NOT EXISTS outbox_pending_idx ON outbox (id) WHERE delivered_at IS NULL;
+
+ALTER TABLE outbox DROP COLUMN IF EXISTS failed_at;
\ No newline at end of file
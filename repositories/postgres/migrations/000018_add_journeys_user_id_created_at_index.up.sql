@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS journeys_user_id_created_at_idx ON journeys (user_id, created_at, id);
\ No newline at end of file
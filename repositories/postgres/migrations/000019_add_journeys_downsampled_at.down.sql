@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS journeys_downsample_idx;
+
+CREATE INDEX IF NOT EXISTS journeys_completed_at_idx ON journeys (completed_at) WHERE completed_at IS NOT NULL;
+
+ALTER TABLE journeys DROP COLUMN IF EXISTS downsampled_at;
\ No newline at end of file
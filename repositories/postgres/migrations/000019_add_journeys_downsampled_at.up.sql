@@ -0,0 +1,5 @@
+ALTER TABLE journeys ADD COLUMN IF NOT EXISTS downsampled_at TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS journeys_completed_at_idx;
+
+CREATE INDEX IF NOT EXISTS journeys_downsample_idx ON journeys (completed_at) WHERE completed_at IS NOT NULL AND downsampled_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,118 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"github.com/cobbinma/track-api/graph/model"
+	"time"
+)
+
+var ErrNotFound = errors.New("not found")
+
+// JourneyCursor is the position of a journey in a user's history, which is ordered newest first.
+type JourneyCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// OutboxEvent is a journey update or geofence crossing committed alongside the change it describes, waiting to be
+// delivered to subscribers. Exactly one of Journey and GeofenceCrossing is set.
+type OutboxEvent struct {
+	ID               int64
+	Journey          *model.Journey
+	GeofenceCrossing *model.GeofenceCrossing
+	Attempts         int
+}
+
+// Repository stores journeys and the positions recorded along them.
+type Repository interface {
+	GetJourney(ctx context.Context, id string) (*model.Journey, error)
+	// GetJourneyForUpdate returns the journey, locking it until the current transaction ends so that concurrent
+	// changes to it are made one after another.
+	GetJourneyForUpdate(ctx context.Context, id string) (*model.Journey, error)
+	// ListJourneys returns up to limit journeys belonging to the user, newest first, starting after the given cursor.
+	ListJourneys(ctx context.Context, userID string, status *model.JourneyStatus, after *JourneyCursor, limit uint64) ([]*model.Journey, error)
+	// GetActiveJourney returns the user's most recently updated active journey, or ErrNotFound if they have none.
+	GetActiveJourney(ctx context.Context, userID string) (*model.Journey, error)
+	// ListIdleJourneys returns up to limit active journeys last updated before the given time, least recently updated
+	// first.
+	ListIdleJourneys(ctx context.Context, before time.Time, limit uint64) ([]*model.Journey, error)
+	CreateJourney(ctx context.Context, journey *model.Journey) error
+	UpdatePosition(ctx context.Context, id string, position *model.Position) error
+	AddPosition(ctx context.Context, id string, position *model.Position) error
+	// AddPositions appends the points to the journey's track in the order given, numbering them after its existing
+	// positions. The journey's current position is not changed.
+	AddPositions(ctx context.Context, id string, points []*model.TrackPoint) error
+	ListPositions(ctx context.Context, id string, since *time.Time, after *int, limit uint64) ([]*model.TrackPoint, error)
+	// ListRecentPositions returns the latest limit positions of the journey's track recorded since the given time, in
+	// recorded order.
+	ListRecentPositions(ctx context.Context, id string, since time.Time, limit uint64) ([]*model.TrackPoint, error)
+	// StreamPositions calls fn with each position of the journey's track in recorded order, stopping at the first
+	// error.
+	StreamPositions(ctx context.Context, id string, fn func(point *model.TrackPoint) error) error
+	UpdateStatus(ctx context.Context, id string, status model.JourneyStatus) error
+	UpdateStats(ctx context.Context, id string, stats *model.JourneyStats) error
+	// UpdateETA sets the distance remaining to the journey's destination and the estimated time of arrival there.
+	UpdateETA(ctx context.Context, id string, remainingDistance *float64, eta *time.Time) error
+	AddViewer(ctx context.Context, journeyID, userID string) error
+	RemoveViewer(ctx context.Context, journeyID, userID string) error
+	IsViewer(ctx context.Context, journeyID, userID string) (bool, error)
+	ListViewers(ctx context.Context, journeyID string) ([]string, error)
+	CreateShareLink(ctx context.Context, link *model.ShareLink) error
+	GetShareLink(ctx context.Context, id string) (*model.ShareLink, error)
+	RevokeShareLink(ctx context.Context, id string) error
+	// AddJourneyLogEvent appends the event to the journey's log, setting its sequence number.
+	AddJourneyLogEvent(ctx context.Context, event *model.JourneyLogEvent) error
+	// ListJourneyLogEvents returns up to limit events from the journey's log, oldest first, starting after the given
+	// sequence number.
+	ListJourneyLogEvents(ctx context.Context, journeyID string, after *int64, limit uint64) ([]*model.JourneyLogEvent, error)
+	// IncrementVersion increments the version of the journey, returning the new version.
+	IncrementVersion(ctx context.Context, id string) (int, error)
+	// ListJourneyVersions returns up to limit versions of the journey recorded in the outbox after the given version,
+	// oldest first.
+	ListJourneyVersions(ctx context.Context, id string, since int, limit uint64) ([]*model.Journey, error)
+	// AddOutboxEvent records the journey to be delivered to subscribers once the current transaction commits.
+	AddOutboxEvent(ctx context.Context, journey *model.Journey) error
+	// AddGeofenceOutboxEvent records the crossing to be delivered to the owner of the geofence once the current
+	// transaction commits.
+	AddGeofenceOutboxEvent(ctx context.Context, crossing *model.GeofenceCrossing) error
+	// ClaimOutboxEvents returns up to limit undelivered events that are due, oldest first. Within a transaction the
+	// events are locked from other claims until it ends.
+	ClaimOutboxEvents(ctx context.Context, limit uint64) ([]*OutboxEvent, error)
+	MarkOutboxEventDelivered(ctx context.Context, id int64) error
+	// RetryOutboxEvent counts a failed delivery attempt and makes the event due again at the given time.
+	RetryOutboxEvent(ctx context.Context, id int64, at time.Time) error
+	// RescheduleOutboxEvent makes the event due again at the given time without counting a delivery attempt.
+	RescheduleOutboxEvent(ctx context.Context, id int64, at time.Time) error
+	// FailOutboxEvent counts a failed delivery attempt and gives up on the event, so it is no longer claimed.
+	FailOutboxEvent(ctx context.Context, id int64) error
+	CreateGeofence(ctx context.Context, fence *model.Geofence) error
+	GetGeofence(ctx context.Context, id string) (*model.Geofence, error)
+	// ListGeofences returns the geofences owned by any of the users, ordered by id.
+	ListGeofences(ctx context.Context, userIDs []string) ([]*model.Geofence, error)
+	UpdateGeofence(ctx context.Context, fence *model.Geofence) error
+	DeleteGeofence(ctx context.Context, id string) error
+	// CountJourneysUpdatedBefore returns how many journeys in the status were last updated before the given time.
+	CountJourneysUpdatedBefore(ctx context.Context, status model.JourneyStatus, before time.Time) (int64, error)
+	// DeleteJourneysUpdatedBefore deletes up to limit journeys in the status last updated before the given time, least
+	// recently updated first, along with their tracks, logs, shares and outbox events. It returns how many journeys
+	// were deleted.
+	DeleteJourneysUpdatedBefore(ctx context.Context, status model.JourneyStatus, before time.Time, limit uint64) (int64, error)
+	// CountDownsamplePositions returns how many positions DownsampleJourneys would delete from the tracks of journeys
+	// completed before the given time that have not been down-sampled.
+	CountDownsamplePositions(ctx context.Context, before time.Time, interval time.Duration) (int64, error)
+	// DownsampleJourneys down-samples the tracks of up to limit journeys completed before the given time that have not
+	// been already, keeping the first position recorded in each interval and the last position of each track. A zero
+	// interval deletes the whole track. Positions are also removed from the journeys' logs, their outbox events are
+	// deleted and their versions incremented so paths simplified from the old tracks are not used. It returns how many
+	// journeys were down-sampled and how many positions were deleted.
+	DownsampleJourneys(ctx context.Context, before time.Time, interval time.Duration, limit uint64) (int64, int64, error)
+	// CountOutboxEventsSettledBefore returns how many outbox events were delivered or given up on before the given
+	// time.
+	CountOutboxEventsSettledBefore(ctx context.Context, before time.Time) (int64, error)
+	// DeleteOutboxEventsSettledBefore deletes up to limit outbox events delivered or given up on before the given
+	// time, returning how many were deleted.
+	DeleteOutboxEventsSettledBefore(ctx context.Context, before time.Time, limit uint64) (int64, error)
+	// Transaction runs fn so that the repository calls it makes with ctx are applied together or not at all.
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
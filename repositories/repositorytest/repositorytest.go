@@ -0,0 +1,689 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/repositories"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+// Run checks that the repository behaves as the resolvers expect. Each test stores journeys for new users, so the
+// repository may be shared between tests and hold data from earlier runs.
+func Run(t *testing.T, repository repositories.Repository) {
+	t.Run("journeys", func(t *testing.T) { testJourneys(t, repository) })
+	t.Run("listing", func(t *testing.T) { testListing(t, repository) })
+	t.Run("idle journeys", func(t *testing.T) { testIdleJourneys(t, repository) })
+	t.Run("positions", func(t *testing.T) { testPositions(t, repository) })
+	t.Run("viewers", func(t *testing.T) { testViewers(t, repository) })
+	t.Run("share links", func(t *testing.T) { testShareLinks(t, repository) })
+	t.Run("geofences", func(t *testing.T) { testGeofences(t, repository) })
+	t.Run("journey log", func(t *testing.T) { testJourneyLog(t, repository) })
+	t.Run("outbox", func(t *testing.T) { testOutbox(t, repository) })
+	t.Run("retention", func(t *testing.T) { testRetention(t, repository) })
+	t.Run("down-sampling", func(t *testing.T) { testDownsampling(t, repository) })
+}
+
+// createJourney stores a new journey for the user in the status, failing the test if it cannot.
+func createJourney(t *testing.T, repository repositories.Repository, userID string,
+	status model.JourneyStatus) *model.Journey {
+	t.Helper()
+
+	// postgres stores timestamps to the microsecond
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	journey := &model.Journey{
+		ID:        uuid.New().String(),
+		User:      &model.User{ID: userID},
+		Status:    status,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := repository.CreateJourney(context.Background(), journey); err != nil {
+		t.Fatalf("unable to create journey: %v", err)
+	}
+
+	return journey
+}
+
+func testJourneys(t *testing.T, repository repositories.Repository) {
+	ctx := context.Background()
+
+	if _, err := repository.GetJourney(ctx, uuid.New().String()); !errors.Is(err, repositories.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown journey, got %v", err)
+	}
+
+	created := createJourney(t, repository, uuid.New().String(), model.JourneyStatusActive)
+	journey, err := repository.GetJourney(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("unable to get journey: %v", err)
+	}
+	if journey.ID != created.ID || journey.User.ID != created.User.ID || journey.Status != created.Status {
+		t.Errorf("expected %+v, got %+v", created, journey)
+	}
+	if journey.Position != nil {
+		t.Errorf("expected a new journey to have no position, got %+v", journey.Position)
+	}
+	if !journey.CreatedAt.Equal(created.CreatedAt) || journey.CompletedAt != nil {
+		t.Errorf("expected created at %s and not completed, got %s and %v", created.CreatedAt, journey.CreatedAt,
+			journey.CompletedAt)
+	}
+
+	if err := repository.Transaction(ctx, func(ctx context.Context) error {
+		if _, err := repository.GetJourneyForUpdate(ctx, uuid.New().String()); !errors.Is(err, repositories.ErrNotFound) {
+			t.Errorf("expected ErrNotFound locking an unknown journey, got %v", err)
+		}
+
+		locked, err := repository.GetJourneyForUpdate(ctx, created.ID)
+		if err != nil {
+			return err
+		}
+		if locked.ID != created.ID || locked.Status != created.Status {
+			t.Errorf("expected %+v, got %+v", created, locked)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("unable to lock journey: %v", err)
+	}
+
+	if err := repository.UpdatePosition(ctx, created.ID, &model.Position{Lat: 51.5, Lng: -0.12}); err != nil {
+		t.Fatalf("unable to update position: %v", err)
+	}
+	if err := repository.UpdateStatus(ctx, created.ID, model.JourneyStatusComplete); err != nil {
+		t.Fatalf("unable to update status: %v", err)
+	}
+	journey, err = repository.GetJourney(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("unable to get journey: %v", err)
+	}
+	if journey.Status != model.JourneyStatusComplete {
+		t.Errorf("expected status %s, got %s", model.JourneyStatusComplete, journey.Status)
+	}
+	if journey.CompletedAt == nil {
+		t.Error("expected completing the journey to record when")
+	}
+	if p := journey.Position; p == nil || p.Lat != 51.5 || p.Lng != -0.12 {
+		t.Errorf("expected position 51.5, -0.12, got %+v", p)
+	}
+
+	if err := repository.UpdatePosition(ctx, created.ID, nil); err != nil {
+		t.Fatalf("unable to clear position: %v", err)
+	}
+	journey, err = repository.GetJourney(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("unable to get journey: %v", err)
+	}
+	if journey.Position != nil {
+		t.Errorf("expected position to be cleared, got %+v", journey.Position)
+	}
+}
+
+func testListing(t *testing.T, repository repositories.Repository) {
+	ctx := context.Background()
+	user := uuid.New().String()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	journey := func(status model.JourneyStatus, createdAt, updatedAt time.Duration) *model.Journey {
+		t.Helper()
+		j := &model.Journey{
+			ID:        uuid.New().String(),
+			User:      &model.User{ID: user},
+			Status:    status,
+			CreatedAt: now.Add(-createdAt),
+			UpdatedAt: now.Add(-updatedAt),
+		}
+		if err := repository.CreateJourney(ctx, j); err != nil {
+			t.Fatalf("unable to create journey: %v", err)
+		}
+		return j
+	}
+
+	// the oldest active journey was updated most recently
+	updated := journey(model.JourneyStatusActive, 3*time.Hour, 0)
+	first := journey(model.JourneyStatusComplete, 2*time.Hour, 2*time.Hour)
+	second := journey(model.JourneyStatusComplete, time.Hour, time.Hour)
+	newest := journey(model.JourneyStatusActive, 30*time.Minute, 30*time.Minute)
+	createJourney(t, repository, uuid.New().String(), model.JourneyStatusActive)
+
+	journeys, err := repository.ListJourneys(ctx, user, nil, nil, 10)
+	if err != nil {
+		t.Fatalf("unable to list journeys: %v", err)
+	}
+	expected := []*model.Journey{newest, second, first, updated}
+	if len(journeys) != len(expected) {
+		t.Fatalf("expected the user's %d journeys, got %d", len(expected), len(journeys))
+	}
+	for i, j := range expected {
+		if journeys[i].ID != j.ID {
+			t.Errorf("expected journey %d to be created at %s, got one created at %s", i, j.CreatedAt,
+				journeys[i].CreatedAt)
+		}
+	}
+
+	complete := model.JourneyStatusComplete
+	var pages []string
+	var after *repositories.JourneyCursor
+	for {
+		page, err := repository.ListJourneys(ctx, user, &complete, after, 1)
+		if err != nil {
+			t.Fatalf("unable to list journeys: %v", err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		if len(page) != 1 {
+			t.Fatalf("expected a page of 1 journey, got %d", len(page))
+		}
+		pages = append(pages, page[0].ID)
+		after = &repositories.JourneyCursor{CreatedAt: page[0].CreatedAt, ID: page[0].ID}
+	}
+	if len(pages) != 2 || pages[0] != second.ID || pages[1] != first.ID {
+		t.Errorf("expected the complete journeys newest first, got %v", pages)
+	}
+
+	active, err := repository.GetActiveJourney(ctx, user)
+	if err != nil {
+		t.Fatalf("unable to get active journey: %v", err)
+	}
+	if active.ID != updated.ID {
+		t.Errorf("expected the most recently updated active journey %s, got %s", updated.ID, active.ID)
+	}
+
+	if _, err := repository.GetActiveJourney(ctx, uuid.New().String()); !errors.Is(err, repositories.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a user without an active journey, got %v", err)
+	}
+}
+
+func testIdleJourneys(t *testing.T, repository repositories.Repository) {
+	ctx := context.Background()
+	user := uuid.New().String()
+
+	// the journeys were last updated long ago, before any left active by earlier runs
+	epoch := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	var journeys []*model.Journey
+	for i, status := range []model.JourneyStatus{
+		model.JourneyStatusComplete,
+		model.JourneyStatusActive,
+		model.JourneyStatusActive,
+	} {
+		journey := &model.Journey{
+			ID:        uuid.New().String(),
+			User:      &model.User{ID: user},
+			Status:    status,
+			CreatedAt: epoch,
+			UpdatedAt: epoch.Add(time.Duration(i) * time.Hour),
+		}
+		if err := repository.CreateJourney(ctx, journey); err != nil {
+			t.Fatalf("unable to create journey: %v", err)
+		}
+		journeys = append(journeys, journey)
+	}
+	defer func() {
+		for _, j := range journeys {
+			if err := repository.UpdateStatus(ctx, j.ID, model.JourneyStatusComplete); err != nil {
+				t.Errorf("unable to complete journey: %v", err)
+			}
+		}
+	}()
+
+	idle, err := repository.ListIdleJourneys(ctx, epoch.Add(3*time.Hour), 10)
+	if err != nil {
+		t.Fatalf("unable to list idle journeys: %v", err)
+	}
+	if len(idle) != 2 || idle[0].ID != journeys[1].ID || idle[1].ID != journeys[2].ID {
+		t.Fatalf("expected the active journeys least recently updated first, got %v", idle)
+	}
+
+	idle, err = repository.ListIdleJourneys(ctx, epoch.Add(2*time.Hour), 10)
+	if err != nil {
+		t.Fatalf("unable to list idle journeys: %v", err)
+	}
+	if len(idle) != 1 || idle[0].ID != journeys[1].ID {
+		t.Errorf("expected only the journey updated before the time, got %v", idle)
+	}
+}
+
+func testPositions(t *testing.T, repository repositories.Repository) {
+	ctx := context.Background()
+	journey := createJourney(t, repository, uuid.New().String(), model.JourneyStatusActive)
+
+	positions := []*model.Position{{Lat: 51.5, Lng: -0.12}, {Lat: 51.501, Lng: -0.121}, {Lat: 51.502, Lng: -0.122}}
+	for _, p := range positions {
+		if err := repository.AddPosition(ctx, journey.ID, p); err != nil {
+			t.Fatalf("unable to add position: %v", err)
+		}
+	}
+
+	got, err := repository.GetJourney(ctx, journey.ID)
+	if err != nil {
+		t.Fatalf("unable to get journey: %v", err)
+	}
+	if p := got.Position; p == nil || p.Lat != 51.502 || p.Lng != -0.122 {
+		t.Errorf("expected the last position to be current, got %+v", p)
+	}
+
+	points, err := repository.ListPositions(ctx, journey.ID, nil, nil, 10)
+	if err != nil {
+		t.Fatalf("unable to list positions: %v", err)
+	}
+	if len(points) != len(positions) {
+		t.Fatalf("expected %d positions, got %d", len(positions), len(points))
+	}
+	for i, p := range points {
+		if p.Seq != i+1 || p.Lat != positions[i].Lat || p.Lng != positions[i].Lng {
+			t.Errorf("expected position %d at %v, %v, got %+v", i+1, positions[i].Lat, positions[i].Lng, p)
+		}
+	}
+
+	after := 1
+	points, err = repository.ListPositions(ctx, journey.ID, nil, &after, 1)
+	if err != nil {
+		t.Fatalf("unable to list positions: %v", err)
+	}
+	if len(points) != 1 || points[0].Seq != 2 {
+		t.Errorf("expected only position 2, got %+v", points)
+	}
+
+	points, err = repository.ListRecentPositions(ctx, journey.ID, time.Now().Add(-time.Hour), 2)
+	if err != nil {
+		t.Fatalf("unable to list recent positions: %v", err)
+	}
+	if len(points) != 2 || points[0].Seq != 2 || points[1].Seq != 3 {
+		t.Errorf("expected the latest 2 positions in recorded order, got %+v", points)
+	}
+}
+
+func testViewers(t *testing.T, repository repositories.Repository) {
+	ctx := context.Background()
+	journey := createJourney(t, repository, uuid.New().String(), model.JourneyStatusActive)
+	viewer := uuid.New().String()
+
+	isViewer := func() bool {
+		t.Helper()
+		ok, err := repository.IsViewer(ctx, journey.ID, viewer)
+		if err != nil {
+			t.Fatalf("unable to check viewer: %v", err)
+		}
+		return ok
+	}
+
+	if isViewer() {
+		t.Fatal("expected a journey not to be shared until a viewer is added")
+	}
+
+	// adding a viewer twice is not an error
+	for i := 0; i < 2; i++ {
+		if err := repository.AddViewer(ctx, journey.ID, viewer); err != nil {
+			t.Fatalf("unable to add viewer: %v", err)
+		}
+	}
+	if !isViewer() {
+		t.Error("expected the user to be a viewer once added")
+	}
+	viewers, err := repository.ListViewers(ctx, journey.ID)
+	if err != nil {
+		t.Fatalf("unable to list viewers: %v", err)
+	}
+	if len(viewers) != 1 || viewers[0] != viewer {
+		t.Errorf("expected viewers [%s], got %v", viewer, viewers)
+	}
+
+	if err := repository.RemoveViewer(ctx, journey.ID, viewer); err != nil {
+		t.Fatalf("unable to remove viewer: %v", err)
+	}
+	if isViewer() {
+		t.Error("expected the user not to be a viewer once removed")
+	}
+}
+
+func testShareLinks(t *testing.T, repository repositories.Repository) {
+	ctx := context.Background()
+	journey := createJourney(t, repository, uuid.New().String(), model.JourneyStatusActive)
+
+	if _, err := repository.GetShareLink(ctx, uuid.New().String()); !errors.Is(err, repositories.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown share link, got %v", err)
+	}
+
+	created := &model.ShareLink{
+		ID:        uuid.New().String(),
+		JourneyID: journey.ID,
+		ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+	if err := repository.CreateShareLink(ctx, created); err != nil {
+		t.Fatalf("unable to create share link: %v", err)
+	}
+
+	link, err := repository.GetShareLink(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("unable to get share link: %v", err)
+	}
+	if link.JourneyID != journey.ID || !link.ExpiresAt.Equal(created.ExpiresAt) || link.Revoked {
+		t.Errorf("expected %+v, got %+v", created, link)
+	}
+
+	if err := repository.RevokeShareLink(ctx, created.ID); err != nil {
+		t.Fatalf("unable to revoke share link: %v", err)
+	}
+	link, err = repository.GetShareLink(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("unable to get share link: %v", err)
+	}
+	if !link.Revoked {
+		t.Error("expected the share link to be revoked")
+	}
+}
+
+func testGeofences(t *testing.T, repository repositories.Repository) {
+	ctx := context.Background()
+	user := uuid.New().String()
+
+	if _, err := repository.GetGeofence(ctx, uuid.New().String()); !errors.Is(err, repositories.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown geofence, got %v", err)
+	}
+
+	radius := 50.0
+	circle := &model.Geofence{
+		ID:     uuid.New().String(),
+		User:   &model.User{ID: user},
+		Name:   "home",
+		Shape:  model.GeofenceShapeCircle,
+		Center: &model.Coordinates{Lat: 51.5, Lng: -0.12},
+		Radius: &radius,
+	}
+	polygon := &model.Geofence{
+		ID:      uuid.New().String(),
+		User:    &model.User{ID: user},
+		Name:    "park",
+		Shape:   model.GeofenceShapePolygon,
+		Polygon: []*model.Coordinates{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 1}, {Lat: 1, Lng: 1}},
+	}
+	other := &model.Geofence{
+		ID:     uuid.New().String(),
+		User:   &model.User{ID: uuid.New().String()},
+		Name:   "work",
+		Shape:  model.GeofenceShapeCircle,
+		Center: &model.Coordinates{Lat: 51.5, Lng: -0.12},
+		Radius: &radius,
+	}
+	for _, fence := range []*model.Geofence{circle, polygon, other} {
+		if err := repository.CreateGeofence(ctx, fence); err != nil {
+			t.Fatalf("unable to create geofence: %v", err)
+		}
+	}
+
+	fence, err := repository.GetGeofence(ctx, circle.ID)
+	if err != nil {
+		t.Fatalf("unable to get geofence: %v", err)
+	}
+	if fence.User.ID != user || fence.Name != "home" || fence.Shape != model.GeofenceShapeCircle ||
+		fence.Center == nil || *fence.Center != *circle.Center || fence.Radius == nil || *fence.Radius != radius {
+		t.Errorf("expected %+v, got %+v", circle, fence)
+	}
+
+	fence, err = repository.GetGeofence(ctx, polygon.ID)
+	if err != nil {
+		t.Fatalf("unable to get geofence: %v", err)
+	}
+	if len(fence.Polygon) != 3 || *fence.Polygon[2] != *polygon.Polygon[2] {
+		t.Errorf("expected vertices %v, got %v", polygon.Polygon, fence.Polygon)
+	}
+
+	fences, err := repository.ListGeofences(ctx, []string{user})
+	if err != nil {
+		t.Fatalf("unable to list geofences: %v", err)
+	}
+	if len(fences) != 2 {
+		t.Fatalf("expected the user's 2 geofences, got %d", len(fences))
+	}
+	if fences[0].ID > fences[1].ID {
+		t.Errorf("expected geofences ordered by id, got %s before %s", fences[0].ID, fences[1].ID)
+	}
+
+	circle.Name = "new home"
+	if err := repository.UpdateGeofence(ctx, circle); err != nil {
+		t.Fatalf("unable to update geofence: %v", err)
+	}
+	if fence, err := repository.GetGeofence(ctx, circle.ID); err != nil || fence.Name != "new home" {
+		t.Errorf("expected the geofence to be renamed, got %+v, %v", fence, err)
+	}
+
+	if err := repository.DeleteGeofence(ctx, circle.ID); err != nil {
+		t.Fatalf("unable to delete geofence: %v", err)
+	}
+	if _, err := repository.GetGeofence(ctx, circle.ID); !errors.Is(err, repositories.ErrNotFound) {
+		t.Errorf("expected ErrNotFound once deleted, got %v", err)
+	}
+}
+
+func testJourneyLog(t *testing.T, repository repositories.Repository) {
+	ctx := context.Background()
+	journey := createJourney(t, repository, uuid.New().String(), model.JourneyStatusActive)
+	other := createJourney(t, repository, uuid.New().String(), model.JourneyStatusActive)
+
+	active, paused := model.JourneyStatusActive, model.JourneyStatusPaused
+	events := []*model.JourneyLogEvent{
+		{JourneyID: journey.ID, Action: model.JourneyLogActionCreated, Actor: journey.User, Status: &active},
+		{JourneyID: other.ID, Action: model.JourneyLogActionCreated, Actor: other.User, Status: &active},
+		{JourneyID: journey.ID, Action: model.JourneyLogActionShared, Actor: journey.User, Viewer: other.User},
+		{JourneyID: journey.ID, Action: model.JourneyLogActionStatusChanged, PreviousStatus: &active, Status: &paused},
+	}
+	for _, e := range events {
+		e.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+		if err := repository.AddJourneyLogEvent(ctx, e); err != nil {
+			t.Fatalf("unable to add journey log event: %v", err)
+		}
+	}
+	if events[0].Seq >= events[2].Seq || events[2].Seq >= events[3].Seq {
+		t.Errorf("expected events to be numbered in the order they were added, got %d, %d, %d", events[0].Seq,
+			events[2].Seq, events[3].Seq)
+	}
+
+	got, err := repository.ListJourneyLogEvents(ctx, journey.ID, nil, 10)
+	if err != nil {
+		t.Fatalf("unable to list journey log events: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected the journey's 3 events, got %d", len(got))
+	}
+	for i, e := range []*model.JourneyLogEvent{events[0], events[2], events[3]} {
+		if got[i].Seq != e.Seq || got[i].Action != e.Action || !got[i].CreatedAt.Equal(e.CreatedAt) {
+			t.Errorf("expected event %d to be %+v, got %+v", i, e, got[i])
+		}
+	}
+	if v := got[1].Viewer; v == nil || v.ID != other.User.ID {
+		t.Errorf("expected the share to keep its viewer, got %+v", v)
+	}
+	if e := got[2]; e.Actor != nil || e.PreviousStatus == nil || *e.PreviousStatus != active || *e.Status != paused {
+		t.Errorf("expected a status change from ACTIVE to PAUSED made by the server, got %+v", e)
+	}
+
+	got, err = repository.ListJourneyLogEvents(ctx, journey.ID, &events[0].Seq, 1)
+	if err != nil {
+		t.Fatalf("unable to list journey log events: %v", err)
+	}
+	if len(got) != 1 || got[0].Seq != events[2].Seq {
+		t.Errorf("expected only the share after the first event, got %+v", got)
+	}
+}
+
+func testOutbox(t *testing.T, repository repositories.Repository) {
+	ctx := context.Background()
+	journey := createJourney(t, repository, uuid.New().String(), model.JourneyStatusActive)
+	if err := repository.AddOutboxEvent(ctx, journey); err != nil {
+		t.Fatalf("unable to add outbox event: %v", err)
+	}
+
+	// claim returns the journey's event if it is due, events left by other tests may be claimed alongside it
+	claim := func() *repositories.OutboxEvent {
+		t.Helper()
+		var claimed *repositories.OutboxEvent
+		if err := repository.Transaction(ctx, func(ctx context.Context) error {
+			events, err := repository.ClaimOutboxEvents(ctx, 1000)
+			for _, e := range events {
+				if e.Journey != nil && e.Journey.ID == journey.ID {
+					claimed = e
+				}
+			}
+			return err
+		}); err != nil {
+			t.Fatalf("unable to claim outbox events: %v", err)
+		}
+		return claimed
+	}
+
+	e := claim()
+	if e == nil || e.Attempts != 0 {
+		t.Fatalf("expected the event to be due without attempts, got %+v", e)
+	}
+
+	past := time.Now().Add(-time.Minute)
+	if err := repository.RetryOutboxEvent(ctx, e.ID, past); err != nil {
+		t.Fatalf("unable to retry outbox event: %v", err)
+	}
+	if e := claim(); e == nil || e.Attempts != 1 {
+		t.Fatalf("expected a retry to count an attempt, got %+v", e)
+	}
+
+	if err := repository.RescheduleOutboxEvent(ctx, e.ID, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("unable to reschedule outbox event: %v", err)
+	}
+	if e := claim(); e != nil {
+		t.Errorf("expected a rescheduled event not to be due, got %+v", e)
+	}
+	if err := repository.RescheduleOutboxEvent(ctx, e.ID, past); err != nil {
+		t.Fatalf("unable to reschedule outbox event: %v", err)
+	}
+	if e := claim(); e == nil || e.Attempts != 1 {
+		t.Errorf("expected rescheduling not to count an attempt, got %+v", e)
+	}
+
+	if err := repository.MarkOutboxEventDelivered(ctx, e.ID); err != nil {
+		t.Fatalf("unable to mark outbox event delivered: %v", err)
+	}
+	if e := claim(); e != nil {
+		t.Errorf("expected a delivered event not to be claimed, got %+v", e)
+	}
+}
+
+func testRetention(t *testing.T, repository repositories.Repository) {
+	ctx := context.Background()
+
+	// the journey was last updated long ago, so only journeys left by earlier runs are purged with it
+	updatedAt := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	journey := &model.Journey{
+		ID:        uuid.New().String(),
+		User:      &model.User{ID: uuid.New().String()},
+		Status:    model.JourneyStatusCancelled,
+		CreatedAt: updatedAt,
+		UpdatedAt: updatedAt,
+	}
+	if err := repository.CreateJourney(ctx, journey); err != nil {
+		t.Fatalf("unable to create journey: %v", err)
+	}
+	viewer := uuid.New().String()
+	if err := repository.AddViewer(ctx, journey.ID, viewer); err != nil {
+		t.Fatalf("unable to add viewer: %v", err)
+	}
+	if err := repository.AddJourneyLogEvent(ctx, &model.JourneyLogEvent{
+		JourneyID: journey.ID,
+		Action:    model.JourneyLogActionShared,
+		Viewer:    &model.User{ID: viewer},
+		CreatedAt: updatedAt,
+	}); err != nil {
+		t.Fatalf("unable to add journey log event: %v", err)
+	}
+	kept := createJourney(t, repository, journey.User.ID, model.JourneyStatusCancelled)
+
+	before := updatedAt.Add(time.Hour)
+	if n, err := repository.CountJourneysUpdatedBefore(ctx, model.JourneyStatusCancelled, before); err != nil || n < 1 {
+		t.Fatalf("expected the journey to be counted, got %d, %v", n, err)
+	}
+	if n, err := repository.CountJourneysUpdatedBefore(ctx, model.JourneyStatusComplete, before); err != nil || n != 0 {
+		t.Errorf("expected no journeys in another status to be counted, got %d, %v", n, err)
+	}
+
+	if n, err := repository.DeleteJourneysUpdatedBefore(ctx, model.JourneyStatusCancelled, before, 1000); err != nil ||
+		n < 1 {
+		t.Fatalf("expected the journey to be deleted, got %d, %v", n, err)
+	}
+
+	if _, err := repository.GetJourney(ctx, journey.ID); !errors.Is(err, repositories.ErrNotFound) {
+		t.Errorf("expected the journey to be deleted, got %v", err)
+	}
+	if ok, err := repository.IsViewer(ctx, journey.ID, viewer); err != nil || ok {
+		t.Errorf("expected the journey's shares to be deleted, got %v, %v", ok, err)
+	}
+	if events, err := repository.ListJourneyLogEvents(ctx, journey.ID, nil, 10); err != nil || len(events) != 0 {
+		t.Errorf("expected the journey's log to be deleted, got %d events, %v", len(events), err)
+	}
+	if _, err := repository.GetJourney(ctx, kept.ID); err != nil {
+		t.Errorf("expected a recently updated journey to be kept, got %v", err)
+	}
+}
+
+func testDownsampling(t *testing.T, repository repositories.Repository) {
+	ctx := context.Background()
+
+	// the journey was completed long ago, so only journeys left by earlier runs are down-sampled with it
+	completedAt := time.Date(1990, 1, 1, 12, 0, 0, 0, time.UTC)
+	journey := &model.Journey{
+		ID:          uuid.New().String(),
+		User:        &model.User{ID: uuid.New().String()},
+		Status:      model.JourneyStatusComplete,
+		CreatedAt:   completedAt.Add(-2 * time.Hour),
+		CompletedAt: &completedAt,
+		UpdatedAt:   completedAt,
+	}
+	if err := repository.CreateJourney(ctx, journey); err != nil {
+		t.Fatalf("unable to create journey: %v", err)
+	}
+	for _, minutes := range []int{0, 10, 20, 65} {
+		recordedAt := journey.CreatedAt.Add(time.Duration(minutes) * time.Minute)
+		if err := repository.AddPosition(ctx, journey.ID, &model.Position{Lat: 51.5, Lng: -0.12,
+			RecordedAt: &recordedAt}); err != nil {
+			t.Fatalf("unable to add position: %v", err)
+		}
+	}
+	if err := repository.AddJourneyLogEvent(ctx, &model.JourneyLogEvent{
+		JourneyID: journey.ID,
+		Action:    model.JourneyLogActionPositionUpdated,
+		Position:  &model.Position{Lat: 51.5, Lng: -0.12},
+		CreatedAt: completedAt,
+	}); err != nil {
+		t.Fatalf("unable to add journey log event: %v", err)
+	}
+
+	before := completedAt.Add(time.Hour)
+	if n, err := repository.CountDownsamplePositions(ctx, before, time.Hour); err != nil || n < 2 {
+		t.Fatalf("expected the journey's excess positions to be counted, got %d, %v", n, err)
+	}
+	if n, deleted, err := repository.DownsampleJourneys(ctx, before, time.Hour, 1000); err != nil || n < 1 ||
+		deleted < 2 {
+		t.Fatalf("expected the journey to be down-sampled, got %d journeys, %d positions, %v", n, deleted, err)
+	}
+
+	// the first position in the hour is kept along with the last of the track
+	points, err := repository.ListPositions(ctx, journey.ID, nil, nil, 10)
+	if err != nil {
+		t.Fatalf("unable to list positions: %v", err)
+	}
+	if len(points) != 2 || points[0].Seq != 1 || points[1].Seq != 4 {
+		t.Errorf("expected positions 1 and 4 to be kept, got %+v", points)
+	}
+	events, err := repository.ListJourneyLogEvents(ctx, journey.ID, nil, 10)
+	if err != nil {
+		t.Fatalf("unable to list journey log events: %v", err)
+	}
+	if len(events) != 1 || events[0].Position != nil {
+		t.Errorf("expected the position to be stripped from the journey log, got %+v", events)
+	}
+	// paths cached for the old track are keyed by the old version
+	if got, err := repository.GetJourney(ctx, journey.ID); err != nil || got.Version != journey.Version+1 {
+		t.Errorf("expected the journey's version to be incremented, got %+v, %v", got, err)
+	}
+
+	if n, _, err := repository.DownsampleJourneys(ctx, before, time.Hour, 1000); err != nil || n != 0 {
+		t.Errorf("expected the journey not to be down-sampled again, got %d, %v", n, err)
+	}
+}
@@ -0,0 +1,62 @@
+package retention
+
+import (
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"strings"
+	"time"
+)
+
+// Policy is how long location data is kept.
+type Policy struct {
+	// Journeys is how long journeys in each status are kept after they were last updated. Journeys in a status without
+	// a window are kept.
+	Journeys map[model.JourneyStatus]time.Duration
+	// Tracks is how long the full track of a completed or cancelled journey is kept before it is down-sampled, when
+	// positions are also removed from its log and outbox. Tracks are kept in full when it is zero.
+	Tracks time.Duration
+	// SampleInterval is the time between the positions kept when a track is down-sampled. The whole track is deleted
+	// when it is zero.
+	SampleInterval time.Duration
+	// Outbox is how long updates delivered to subscribers are kept for subscribers resuming from an earlier version.
+	// Delivered updates are kept when it is zero.
+	Outbox time.Duration
+}
+
+// Empty reports whether the policy keeps everything.
+func (p Policy) Empty() bool {
+	return len(p.Journeys) == 0 && p.Tracks == 0 && p.Outbox == 0
+}
+
+// ParseWindows parses retention windows by status given as a comma separated list of status=duration pairs, such as
+// "COMPLETE=8760h,CANCELLED=720h".
+func ParseWindows(s string) (map[model.JourneyStatus]time.Duration, error) {
+	windows := map[model.JourneyStatus]time.Duration{}
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("retention window %q is not status=duration", pair)
+		}
+
+		status := model.JourneyStatus(strings.ToUpper(strings.TrimSpace(parts[0])))
+		if !status.IsValid() {
+			return nil, fmt.Errorf("retention window %q has unknown status", pair)
+		}
+
+		window, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("parse duration : %w", err)
+		}
+		if window <= 0 {
+			return nil, fmt.Errorf("retention window %q must be positive", pair)
+		}
+
+		windows[status] = window
+	}
+
+	return windows, nil
+}
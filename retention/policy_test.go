@@ -0,0 +1,68 @@
+package retention
+
+import (
+	"github.com/cobbinma/track-api/graph/model"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseWindows(t *testing.T) {
+	tests := []struct {
+		name     string
+		windows  string
+		expected map[model.JourneyStatus]time.Duration
+		invalid  bool
+	}{
+		{
+			name:     "empty",
+			windows:  "",
+			expected: map[model.JourneyStatus]time.Duration{},
+		},
+		{
+			name:    "single status",
+			windows: "COMPLETE=8760h",
+			expected: map[model.JourneyStatus]time.Duration{
+				model.JourneyStatusComplete: 8760 * time.Hour,
+			},
+		},
+		{
+			name:    "several statuses",
+			windows: "COMPLETE=8760h,CANCELLED=720h",
+			expected: map[model.JourneyStatus]time.Duration{
+				model.JourneyStatusComplete:  8760 * time.Hour,
+				model.JourneyStatusCancelled: 720 * time.Hour,
+			},
+		},
+		{
+			name:    "spaces, lower case and trailing comma",
+			windows: " complete = 24h , ",
+			expected: map[model.JourneyStatus]time.Duration{
+				model.JourneyStatusComplete: 24 * time.Hour,
+			},
+		},
+		{name: "missing duration", windows: "COMPLETE", invalid: true},
+		{name: "unknown status", windows: "FINISHED=24h", invalid: true},
+		{name: "invalid duration", windows: "COMPLETE=a year", invalid: true},
+		{name: "zero duration", windows: "COMPLETE=0s", invalid: true},
+		{name: "negative duration", windows: "CANCELLED=-1h", invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseWindows(tt.windows)
+			if tt.invalid {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package retention
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/repositories"
+	"github.com/rs/zerolog/log"
+	"strings"
+	"time"
+)
+
+const (
+	// batchSize bounds the rows deleted by a single statement so that purging does not hold locks for long.
+	batchSize = 1000
+	// trackBatchSize bounds the journeys whose tracks are down-sampled at once, as each may have many positions.
+	trackBatchSize = 10
+)
+
+var (
+	// purged counts the rows deleted by each kind of purge.
+	purged = expvar.NewMap("retention_purged")
+	// pending holds the rows each kind of purge would have deleted in the most recent dry run.
+	pending = expvar.NewMap("retention_dry_run")
+)
+
+// Purger deletes location data the policy no longer allows to be kept. In a dry run nothing is deleted and the rows
+// that would have been are counted instead.
+type Purger struct {
+	repository repositories.Repository
+	policy     Policy
+	interval   time.Duration
+	dryRun     bool
+}
+
+func NewPurger(repository repositories.Repository, policy Policy, interval time.Duration, dryRun bool) *Purger {
+	return &Purger{
+		repository: repository,
+		policy:     policy,
+		interval:   interval,
+		dryRun:     dryRun,
+	}
+}
+
+// purge is a kind of row the policy limits, counted by count and deleted by delete up to limit at a time. delete
+// returns how much of the limit it used and how many rows it deleted, which differ when tracks are down-sampled a
+// journey at a time.
+type purge struct {
+	name   string
+	limit  uint64
+	count  func(ctx context.Context) (int64, error)
+	delete func(ctx context.Context, limit uint64) (int64, int64, error)
+}
+
+// Run purges expired rows every interval until ctx is done.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, purge := range p.purges(time.Now()) {
+			if err := p.run(ctx, purge); err != nil {
+				log.Error().Err(err).Str("purge", purge.name).Msg("unable to purge expired rows")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purges returns what the policy limits as of now. Whole journeys are purged before their tracks are down-sampled.
+func (p *Purger) purges(now time.Time) []purge {
+	purges := []purge{}
+	for _, status := range model.AllJourneyStatus {
+		window, ok := p.policy.Journeys[status]
+		if !ok {
+			continue
+		}
+
+		status, before := status, now.Add(-window)
+		purges = append(purges, purge{
+			name:  strings.ToLower(status.String()) + "_journeys",
+			limit: batchSize,
+			count: func(ctx context.Context) (int64, error) {
+				return p.repository.CountJourneysUpdatedBefore(ctx, status, before)
+			},
+			delete: func(ctx context.Context, limit uint64) (int64, int64, error) {
+				n, err := p.repository.DeleteJourneysUpdatedBefore(ctx, status, before, limit)
+				return n, n, err
+			},
+		})
+	}
+
+	if p.policy.Tracks > 0 {
+		before, interval := now.Add(-p.policy.Tracks), p.policy.SampleInterval
+		purges = append(purges, purge{
+			name:  "positions",
+			limit: trackBatchSize,
+			count: func(ctx context.Context) (int64, error) {
+				return p.repository.CountDownsamplePositions(ctx, before, interval)
+			},
+			delete: func(ctx context.Context, limit uint64) (int64, int64, error) {
+				return p.repository.DownsampleJourneys(ctx, before, interval, limit)
+			},
+		})
+	}
+
+	if p.policy.Outbox > 0 {
+		before := now.Add(-p.policy.Outbox)
+		purges = append(purges, purge{
+			name:  "outbox_events",
+			limit: batchSize,
+			count: func(ctx context.Context) (int64, error) {
+				return p.repository.CountOutboxEventsSettledBefore(ctx, before)
+			},
+			delete: func(ctx context.Context, limit uint64) (int64, int64, error) {
+				n, err := p.repository.DeleteOutboxEventsSettledBefore(ctx, before, limit)
+				return n, n, err
+			},
+		})
+	}
+
+	return purges
+}
+
+// run deletes the rows of the purge in batches until a batch is not full, or counts them in a dry run.
+func (p *Purger) run(ctx context.Context, purge purge) error {
+	if p.dryRun {
+		n, err := purge.count(ctx)
+		if err != nil {
+			return fmt.Errorf("count : %w", err)
+		}
+
+		rows := new(expvar.Int)
+		rows.Set(n)
+		pending.Set(purge.name, rows)
+		log.Info().Str("purge", purge.name).Int64("rows", n).Msg("dry run, expired rows not purged")
+
+		return nil
+	}
+
+	var total int64
+	defer func() {
+		purged.Add(purge.name, total)
+		if total > 0 {
+			log.Info().Str("purge", purge.name).Int64("rows", total).Msg("purged expired rows")
+		}
+	}()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		n, rows, err := purge.delete(ctx, purge.limit)
+		total += rows
+		if err != nil {
+			return fmt.Errorf("delete : %w", err)
+		}
+		if uint64(n) < purge.limit {
+			return nil
+		}
+	}
+}
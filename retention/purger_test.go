@@ -0,0 +1,156 @@
+package retention
+
+import (
+	"context"
+	"expvar"
+	"github.com/cobbinma/track-api/graph/model"
+	"github.com/cobbinma/track-api/repositories"
+	"testing"
+	"time"
+)
+
+// fakeRepository holds a number of expired rows of each kind, deleting them up to the limit it is given.
+type fakeRepository struct {
+	repositories.Repository
+	journeys map[model.JourneyStatus]int64
+	tracks   int64
+	// excess is the number of positions down-sampling deletes from each track
+	excess int64
+	outbox int64
+	// before records the time each kind of row was purged up to
+	before map[string]time.Time
+	// deletes counts the delete statements run
+	deletes int
+}
+
+func take(rows *int64, limit uint64) int64 {
+	n := *rows
+	if n > int64(limit) {
+		n = int64(limit)
+	}
+	*rows -= n
+	return n
+}
+
+func (r *fakeRepository) CountJourneysUpdatedBefore(ctx context.Context, status model.JourneyStatus,
+	before time.Time) (int64, error) {
+	return r.journeys[status], nil
+}
+
+func (r *fakeRepository) DeleteJourneysUpdatedBefore(ctx context.Context, status model.JourneyStatus,
+	before time.Time, limit uint64) (int64, error) {
+	r.deletes++
+	r.before[status.String()] = before
+	n := r.journeys[status]
+	deleted := take(&n, limit)
+	r.journeys[status] = n
+	return deleted, nil
+}
+
+func (r *fakeRepository) CountDownsamplePositions(ctx context.Context, before time.Time,
+	interval time.Duration) (int64, error) {
+	return r.tracks * r.excess, nil
+}
+
+func (r *fakeRepository) DownsampleJourneys(ctx context.Context, before time.Time, interval time.Duration,
+	limit uint64) (int64, int64, error) {
+	r.deletes++
+	r.before["positions"] = before
+	n := take(&r.tracks, limit)
+	return n, n * r.excess, nil
+}
+
+func (r *fakeRepository) CountOutboxEventsSettledBefore(ctx context.Context, before time.Time) (int64, error) {
+	return r.outbox, nil
+}
+
+func (r *fakeRepository) DeleteOutboxEventsSettledBefore(ctx context.Context, before time.Time,
+	limit uint64) (int64, error) {
+	r.deletes++
+	r.before["outbox"] = before
+	return take(&r.outbox, limit), nil
+}
+
+// counted returns the value of the metric for the purge, or zero if it has not been recorded.
+func counted(m *expvar.Map, name string) int64 {
+	v, ok := m.Get(name).(*expvar.Int)
+	if !ok {
+		return 0
+	}
+	return v.Value()
+}
+
+func TestPurge(t *testing.T) {
+	repository := &fakeRepository{
+		journeys: map[model.JourneyStatus]int64{model.JourneyStatusCancelled: 2*batchSize + 1},
+		tracks:   trackBatchSize,
+		excess:   3,
+		outbox:   10,
+		before:   map[string]time.Time{},
+	}
+	policy := Policy{
+		Journeys: map[model.JourneyStatus]time.Duration{model.JourneyStatusCancelled: time.Hour},
+		Tracks:   2 * time.Hour,
+		Outbox:   3 * time.Hour,
+	}
+	purger := NewPurger(repository, policy, time.Hour, false)
+
+	journeys, positions := counted(purged, "cancelled_journeys"), counted(purged, "positions")
+	now := time.Now()
+	for _, purge := range purger.purges(now) {
+		if err := purger.run(context.Background(), purge); err != nil {
+			t.Fatalf("unable to purge %s: %v", purge.name, err)
+		}
+	}
+
+	if repository.journeys[model.JourneyStatusCancelled] != 0 || repository.tracks != 0 || repository.outbox != 0 {
+		t.Errorf("expected every expired row to be deleted, got %+v", repository)
+	}
+	// cancelled journeys take three batches, the last not full, tracks fill one batch and need another to find
+	// there are none left, and the outbox is purged in one
+	if repository.deletes != 6 {
+		t.Errorf("expected 6 deletes, got %d", repository.deletes)
+	}
+
+	for kind, window := range map[string]time.Duration{
+		"CANCELLED": time.Hour,
+		"positions": 2 * time.Hour,
+		"outbox":    3 * time.Hour,
+	} {
+		if before := repository.before[kind]; !before.Equal(now.Add(-window)) {
+			t.Errorf("expected %s purged up to %s ago, got %s", kind, window, now.Sub(before))
+		}
+	}
+
+	if n := counted(purged, "cancelled_journeys") - journeys; n != 2*batchSize+1 {
+		t.Errorf("expected %d cancelled journeys counted as purged, got %d", 2*batchSize+1, n)
+	}
+	// positions are counted as the rows deleted rather than the tracks they were deleted from
+	if n := counted(purged, "positions") - positions; n != 3*trackBatchSize {
+		t.Errorf("expected %d positions counted as purged, got %d", 3*trackBatchSize, n)
+	}
+}
+
+func TestPurgeDryRun(t *testing.T) {
+	repository := &fakeRepository{
+		journeys: map[model.JourneyStatus]int64{model.JourneyStatusComplete: 5},
+		before:   map[string]time.Time{},
+	}
+	policy := Policy{Journeys: map[model.JourneyStatus]time.Duration{model.JourneyStatusComplete: time.Hour}}
+	purger := NewPurger(repository, policy, time.Hour, true)
+
+	purges := purger.purges(time.Now())
+	if len(purges) != 1 {
+		t.Fatalf("expected only the journeys the policy limits to be purged, got %d purges", len(purges))
+	}
+	if err := purger.run(context.Background(), purges[0]); err != nil {
+		t.Fatalf("unable to purge: %v", err)
+	}
+
+	if repository.deletes != 0 || repository.journeys[model.JourneyStatusComplete] != 5 {
+		t.Error("expected a dry run not to delete anything")
+	}
+	if n := counted(pending, "complete_journeys"); n != 5 {
+		t.Errorf("expected 5 journeys counted as pending, got %d", n)
+	}
+}
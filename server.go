@@ -1,37 +1,239 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"expvar"
+	"fmt"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/cobbinma/track-api/brokers"
+	"github.com/cobbinma/track-api/brokers/ably"
+	memorybroker "github.com/cobbinma/track-api/brokers/memory"
+	pgbroker "github.com/cobbinma/track-api/brokers/postgres"
 	"github.com/cobbinma/track-api/graph"
 	"github.com/cobbinma/track-api/graph/generated"
+	"github.com/cobbinma/track-api/outbox"
+	"github.com/cobbinma/track-api/repositories"
+	"github.com/cobbinma/track-api/repositories/memory"
 	"github.com/cobbinma/track-api/repositories/postgres"
+	"github.com/cobbinma/track-api/retention"
+	"github.com/cobbinma/track-api/sharelinks"
+	"github.com/cobbinma/track-api/validation"
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
+	"time"
 )
 
-const defaultPort = "8080"
+const (
+	defaultPort    = "8080"
+	outboxInterval = 5 * time.Second
+	// defaultMaxSpeed is the fastest plausible speed between two positions in metres per second.
+	defaultMaxSpeed = 50
+	// defaultMaxClockSkew is how far ahead of the server's clock a position may be recorded.
+	defaultMaxClockSkew = time.Minute
+	// defaultArrivalRadius is the distance in metres from a destination within which a walker has arrived.
+	defaultArrivalRadius = 25
+	defaultArrivalDwell  = 30 * time.Second
+	// defaultJourneyExpiry is how long an active journey may go without an update before it is completed.
+	defaultJourneyExpiry         = time.Hour
+	defaultJourneyExpiryInterval = time.Minute
+	defaultRetentionInterval     = time.Hour
+)
 
 func main() {
 	_ = godotenv.Load()
 
-	dbu, err := url.Parse(os.Getenv("DATABASE_URL"))
-	if err != nil {
-		panic(err)
+	var (
+		repository repositories.Repository
+		dbu        *url.URL
+		pg         *postgres.Client
+		err        error
+	)
+	if databaseURL := os.Getenv("DATABASE_URL"); databaseURL == "" {
+		log.Warn().Msg("DATABASE_URL not given, journeys are kept in memory and will not survive a restart")
+		repository = memory.NewMemory()
+	} else {
+		dbu, err = url.Parse(databaseURL)
+		if err != nil {
+			panic(err)
+		}
+
+		pg, err = postgres.NewPostgres(*dbu, "file://repositories/postgres/migrations")
+		if err != nil {
+			panic(err)
+		}
+		repository = pg
 	}
 
-	pg, err := postgres.NewPostgres(*dbu, "file://repositories/postgres/migrations")
+	broker, err := newBroker(os.Getenv("BROKER"), dbu, pg)
 	if err != nil {
 		panic(err)
 	}
 
+	relay := outbox.NewRelay(repository, broker, outboxInterval)
+	go relay.Run(context.Background())
+
+	secret := []byte(os.Getenv("SHARE_LINK_SECRET"))
+	if len(secret) == 0 {
+		log.Warn().Msg("SHARE_LINK_SECRET not given, share links will not survive a restart")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic(err)
+		}
+	}
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = defaultPort
 	}
 
+	maxSpeed := float64(defaultMaxSpeed)
+	if s := os.Getenv("MAX_POSITION_SPEED"); s != "" {
+		maxSpeed, err = strconv.ParseFloat(s, 64)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	maxSkew := defaultMaxClockSkew
+	if s := os.Getenv("MAX_CLOCK_SKEW"); s != "" {
+		maxSkew, err = time.ParseDuration(s)
+		if err != nil {
+			panic(err)
+		}
+		if maxSkew < 0 {
+			panic(fmt.Errorf("MAX_CLOCK_SKEW must not be negative"))
+		}
+	}
+
+	arrival := graph.Arrival{Radius: defaultArrivalRadius, Dwell: defaultArrivalDwell}
+	if s := os.Getenv("ARRIVAL_RADIUS"); s != "" {
+		arrival.Radius, err = strconv.ParseFloat(s, 64)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if s := os.Getenv("ARRIVAL_DWELL"); s != "" {
+		arrival.Dwell, err = time.ParseDuration(s)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	expiry := graph.Expiry{After: defaultJourneyExpiry, Interval: defaultJourneyExpiryInterval}
+	if s := os.Getenv("JOURNEY_EXPIRY"); s != "" {
+		expiry.After, err = time.ParseDuration(s)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if s := os.Getenv("JOURNEY_EXPIRY_INTERVAL"); s != "" {
+		expiry.Interval, err = time.ParseDuration(s)
+		if err != nil {
+			panic(err)
+		}
+		if expiry.Interval <= 0 {
+			panic(fmt.Errorf("JOURNEY_EXPIRY_INTERVAL must be positive"))
+		}
+	}
+
+	policy, retentionInterval, dryRun := retention.Policy{}, defaultRetentionInterval, false
+	if s := os.Getenv("RETENTION_JOURNEYS"); s != "" {
+		policy.Journeys, err = retention.ParseWindows(s)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if s := os.Getenv("RETENTION_TRACKS"); s != "" {
+		policy.Tracks, err = time.ParseDuration(s)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if s := os.Getenv("RETENTION_SAMPLE_INTERVAL"); s != "" {
+		policy.SampleInterval, err = time.ParseDuration(s)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if s := os.Getenv("RETENTION_OUTBOX"); s != "" {
+		policy.Outbox, err = time.ParseDuration(s)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if s := os.Getenv("RETENTION_INTERVAL"); s != "" {
+		retentionInterval, err = time.ParseDuration(s)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if retentionInterval <= 0 {
+		panic(fmt.Errorf("RETENTION_INTERVAL must be positive"))
+	}
+	if s := os.Getenv("RETENTION_DRY_RUN"); s != "" {
+		dryRun, err = strconv.ParseBool(s)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if !policy.Empty() {
+		go retention.NewPurger(repository, policy, retentionInterval, dryRun).Run(context.Background())
+	}
+
+	if metricsPort := os.Getenv("METRICS_PORT"); metricsPort != "" {
+		go func() {
+			log.Error().Err(http.ListenAndServe(":"+metricsPort, expvar.Handler())).Msg("metrics server stopped")
+		}()
+	}
+
+	resolver := graph.NewResolver(repository, broker, relay, sharelinks.NewSigner(secret),
+		validation.NewValidator(maxSpeed, maxSkew), arrival)
+	if expiry.After > 0 {
+		go resolver.RunExpiry(context.Background(), expiry)
+	}
 	e := graph.NewRouter(echo.New(), handler.New(
-		generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(pg)})))
+		generated.NewExecutableSchema(generated.Config{Resolvers: resolver})), resolver)
 	e.Logger.Fatal(e.Start(":" + port))
 }
+
+// newBroker returns the named broker. The postgres broker shares the postgres repository so that updates are
+// published in the same transaction as the writes they describe.
+func newBroker(name string, dbu *url.URL, pg *postgres.Client) (brokers.Broker, error) {
+	key := os.Getenv("ABLY_API_KEY")
+	if name == "" && key != "" {
+		name = "ably"
+	}
+
+	switch name {
+	case "ably":
+		if key == "" {
+			return nil, fmt.Errorf("ABLY_API_KEY not given")
+		}
+
+		broker, err := ably.NewAbly(key)
+		if err != nil {
+			return nil, err
+		}
+		return broker, nil
+	case "postgres":
+		if pg == nil {
+			return nil, fmt.Errorf("postgres broker requires DATABASE_URL")
+		}
+
+		broker, err := pgbroker.NewPostgres(*dbu, pg)
+		if err != nil {
+			return nil, err
+		}
+		return broker, nil
+	case "memory", "":
+		return memorybroker.NewMemory(), nil
+	default:
+		return nil, fmt.Errorf("unsupported broker: %s", name)
+	}
+}
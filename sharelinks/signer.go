@@ -0,0 +1,57 @@
+package sharelinks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidToken = errors.New("invalid share link token")
+
+// Signer issues and verifies opaque share link tokens, each carrying the id of a share link and a signature over it.
+type Signer struct {
+	secret []byte
+}
+
+func NewSigner(secret []byte) *Signer {
+	return &Signer{secret: secret}
+}
+
+// Sign returns the token for the share link id.
+func (s *Signer) Sign(id string) (string, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return "", fmt.Errorf("parse : %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(append(u[:], s.mac(u[:])...)), nil
+}
+
+// Verify returns the share link id carried by the token, or ErrInvalidToken if it was not issued by this signer.
+func (s *Signer) Verify(token string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) != len(uuid.UUID{})+sha256.Size {
+		return "", ErrInvalidToken
+	}
+
+	id, signature := b[:len(uuid.UUID{})], b[len(uuid.UUID{}):]
+	if !hmac.Equal(signature, s.mac(id)) {
+		return "", ErrInvalidToken
+	}
+
+	u, err := uuid.FromBytes(id)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+
+	return u.String(), nil
+}
+
+func (s *Signer) mac(id []byte) []byte {
+	m := hmac.New(sha256.New, s.secret)
+	m.Write(id)
+	return m.Sum(nil)
+}
@@ -0,0 +1,96 @@
+package sharelinks
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+const id = "5f5d85ae-e538-4d6b-8e0d-a7004ec7f7ad"
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		invalid bool
+	}{
+		{name: "uuid", id: id},
+		{name: "not a uuid", id: "share-link", invalid: true},
+		{name: "empty", id: "", invalid: true},
+	}
+
+	signer := NewSigner([]byte("secret"))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := signer.Sign(tt.id)
+			if tt.invalid {
+				if err == nil {
+					t.Fatalf("expected an error, got token %q", token)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			verified, err := signer.Verify(token)
+			if err != nil {
+				t.Fatalf("unable to verify signed token: %v", err)
+			}
+			if verified != tt.id {
+				t.Errorf("expected id %s, got %s", tt.id, verified)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	signer := NewSigner([]byte("secret"))
+	token, err := signer.Sign(id)
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+
+	other, err := NewSigner([]byte("other secret")).Sign(id)
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		t.Fatalf("unable to decode token: %v", err)
+	}
+	b[len(b)-1] ^= 0xff
+	tampered := base64.RawURLEncoding.EncodeToString(b)
+
+	tests := []struct {
+		name    string
+		token   string
+		invalid bool
+	}{
+		{name: "signed", token: token},
+		{name: "signed with another secret", token: other, invalid: true},
+		{name: "tampered signature", token: tampered, invalid: true},
+		{name: "truncated", token: token[:len(token)-4], invalid: true},
+		{name: "not base64", token: "!!!!", invalid: true},
+		{name: "empty", token: "", invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := signer.Verify(tt.token)
+			if tt.invalid {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Errorf("expected ErrInvalidToken, got %q, %v", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != id {
+				t.Errorf("expected id %s, got %s", id, got)
+			}
+		})
+	}
+}
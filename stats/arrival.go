@@ -0,0 +1,26 @@
+package stats
+
+import (
+	"github.com/cobbinma/track-api/geo"
+	"github.com/cobbinma/track-api/graph/model"
+	"time"
+)
+
+// TimeWithin returns how long the track has stayed within radius metres of the coordinates, measured back from its
+// last point, and false if the last point is outside the radius. The points should be in recorded order.
+func TimeWithin(points []*model.TrackPoint, coordinates *model.Coordinates, radius float64) (time.Duration, bool) {
+	within := func(p *model.TrackPoint) bool {
+		return geo.Distance(p.Lat, p.Lng, coordinates.Lat, coordinates.Lng) <= radius
+	}
+
+	if len(points) == 0 || !within(points[len(points)-1]) {
+		return 0, false
+	}
+
+	last, first := points[len(points)-1], points[len(points)-1]
+	for i := len(points) - 2; i >= 0 && within(points[i]); i-- {
+		first = points[i]
+	}
+
+	return last.RecordedAt.Sub(first.RecordedAt), true
+}
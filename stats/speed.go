@@ -0,0 +1,30 @@
+package stats
+
+import (
+	"github.com/cobbinma/track-api/geo"
+	"github.com/cobbinma/track-api/graph/model"
+)
+
+// RecentSpeed returns the average speed in metres per second along the points, which should be the most recent points
+// of a track in recorded order. When the points do not span any time the speed reported with the last point is used.
+func RecentSpeed(points []*model.TrackPoint) float64 {
+	if len(points) == 0 {
+		return 0
+	}
+
+	first, last := points[0], points[len(points)-1]
+	if seconds := last.RecordedAt.Sub(first.RecordedAt).Seconds(); seconds > 0 {
+		distance := 0.0
+		for i := 1; i < len(points); i++ {
+			distance += geo.Distance(points[i-1].Lat, points[i-1].Lng, points[i].Lat, points[i].Lng)
+		}
+
+		return distance / seconds
+	}
+
+	if last.Speed != nil {
+		return *last.Speed
+	}
+
+	return 0
+}
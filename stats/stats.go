@@ -0,0 +1,54 @@
+package stats
+
+import (
+	"github.com/cobbinma/track-api/geo"
+	"github.com/cobbinma/track-api/graph/model"
+)
+
+// Add returns the statistics of a journey after the next point is recorded following the previous one, which is nil
+// for the first point of the journey. The speed reported with a point is preferred over the speed between the points.
+func Add(s *model.JourneyStats, previous, next *model.TrackPoint) *model.JourneyStats {
+	result := &model.JourneyStats{}
+	if s != nil {
+		*result = *s
+	}
+
+	if result.StartedAt == nil || next.RecordedAt.Before(*result.StartedAt) {
+		startedAt := next.RecordedAt
+		result.StartedAt = &startedAt
+	}
+	if result.EndedAt == nil || next.RecordedAt.After(*result.EndedAt) {
+		endedAt := next.RecordedAt
+		result.EndedAt = &endedAt
+	}
+
+	speed := next.Speed
+	if previous != nil {
+		distance := geo.Distance(previous.Lat, previous.Lng, next.Lat, next.Lng)
+		result.DistanceMeters += distance
+
+		if seconds := next.RecordedAt.Sub(previous.RecordedAt).Seconds(); speed == nil && seconds > 0 {
+			s := distance / seconds
+			speed = &s
+		}
+	}
+	if speed != nil && *speed > result.MaxSpeed {
+		result.MaxSpeed = *speed
+	}
+
+	return Derive(result)
+}
+
+// Derive sets the duration and average speed of the statistics from the distance and the times of the first and last
+// points.
+func Derive(s *model.JourneyStats) *model.JourneyStats {
+	s.DurationSeconds, s.AverageSpeed = 0, 0
+	if s.StartedAt != nil && s.EndedAt != nil {
+		s.DurationSeconds = s.EndedAt.Sub(*s.StartedAt).Seconds()
+	}
+	if s.DurationSeconds > 0 {
+		s.AverageSpeed = s.DistanceMeters / s.DurationSeconds
+	}
+
+	return s
+}
@@ -0,0 +1,226 @@
+package stats
+
+import (
+	"github.com/cobbinma/track-api/geo"
+	"github.com/cobbinma/track-api/graph/model"
+	"math"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2021, 6, 1, 9, 0, 0, 0, time.UTC)
+
+// northOf is the distance in metres between two points a thousandth of a degree of latitude apart.
+var northOf = geo.Distance(0, 0, 0.001, 0)
+
+func point(lat float64, after time.Duration, speed *float64) *model.TrackPoint {
+	return &model.TrackPoint{Lat: lat, Lng: 0, Speed: speed, RecordedAt: start.Add(after)}
+}
+
+func float(f float64) *float64 {
+	return &f
+}
+
+func at(after time.Duration) *time.Time {
+	t := start.Add(after)
+	return &t
+}
+
+func approximately(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name     string
+		stats    *model.JourneyStats
+		previous *model.TrackPoint
+		next     *model.TrackPoint
+		expected model.JourneyStats
+	}{
+		{
+			name:     "first point",
+			next:     point(0, 0, nil),
+			expected: model.JourneyStats{StartedAt: at(0), EndedAt: at(0)},
+		},
+		{
+			name:     "first point with reported speed",
+			next:     point(0, 0, float(3)),
+			expected: model.JourneyStats{MaxSpeed: 3, StartedAt: at(0), EndedAt: at(0)},
+		},
+		{
+			name:     "speed between points",
+			stats:    &model.JourneyStats{StartedAt: at(0), EndedAt: at(0)},
+			previous: point(0, 0, nil),
+			next:     point(0.001, 10*time.Second, nil),
+			expected: model.JourneyStats{
+				DistanceMeters:  northOf,
+				DurationSeconds: 10,
+				AverageSpeed:    northOf / 10,
+				MaxSpeed:        northOf / 10,
+				StartedAt:       at(0),
+				EndedAt:         at(10 * time.Second),
+			},
+		},
+		{
+			name:     "reported speed preferred",
+			stats:    &model.JourneyStats{StartedAt: at(0), EndedAt: at(0)},
+			previous: point(0, 0, nil),
+			next:     point(0.001, 10*time.Second, float(5)),
+			expected: model.JourneyStats{
+				DistanceMeters:  northOf,
+				DurationSeconds: 10,
+				AverageSpeed:    northOf / 10,
+				MaxSpeed:        5,
+				StartedAt:       at(0),
+				EndedAt:         at(10 * time.Second),
+			},
+		},
+		{
+			name:     "slower than fastest",
+			stats:    &model.JourneyStats{DistanceMeters: 100, MaxSpeed: 20, StartedAt: at(0), EndedAt: at(10 * time.Second)},
+			previous: point(0, 10*time.Second, nil),
+			next:     point(0.001, 20*time.Second, nil),
+			expected: model.JourneyStats{
+				DistanceMeters:  100 + northOf,
+				DurationSeconds: 20,
+				AverageSpeed:    (100 + northOf) / 20,
+				MaxSpeed:        20,
+				StartedAt:       at(0),
+				EndedAt:         at(20 * time.Second),
+			},
+		},
+		{
+			name:     "recorded at the same time",
+			stats:    &model.JourneyStats{StartedAt: at(0), EndedAt: at(0)},
+			previous: point(0, 0, nil),
+			next:     point(0.001, 0, nil),
+			expected: model.JourneyStats{DistanceMeters: northOf, StartedAt: at(0), EndedAt: at(0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Add(tt.stats, tt.previous, tt.next)
+
+			if !approximately(got.DistanceMeters, tt.expected.DistanceMeters) {
+				t.Errorf("expected distance %v, got %v", tt.expected.DistanceMeters, got.DistanceMeters)
+			}
+			if !approximately(got.DurationSeconds, tt.expected.DurationSeconds) {
+				t.Errorf("expected duration %v, got %v", tt.expected.DurationSeconds, got.DurationSeconds)
+			}
+			if !approximately(got.AverageSpeed, tt.expected.AverageSpeed) {
+				t.Errorf("expected average speed %v, got %v", tt.expected.AverageSpeed, got.AverageSpeed)
+			}
+			if !approximately(got.MaxSpeed, tt.expected.MaxSpeed) {
+				t.Errorf("expected max speed %v, got %v", tt.expected.MaxSpeed, got.MaxSpeed)
+			}
+			if !got.StartedAt.Equal(*tt.expected.StartedAt) {
+				t.Errorf("expected started at %v, got %v", tt.expected.StartedAt, got.StartedAt)
+			}
+			if !got.EndedAt.Equal(*tt.expected.EndedAt) {
+				t.Errorf("expected ended at %v, got %v", tt.expected.EndedAt, got.EndedAt)
+			}
+		})
+	}
+}
+
+func TestAddDoesNotModifyStats(t *testing.T) {
+	stats := &model.JourneyStats{StartedAt: at(0), EndedAt: at(0)}
+	Add(stats, point(0, 0, nil), point(0.001, 10*time.Second, nil))
+
+	if stats.DistanceMeters != 0 || !stats.EndedAt.Equal(start) {
+		t.Errorf("expected stats to be unchanged, got %+v", stats)
+	}
+}
+
+func TestRecentSpeed(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   []*model.TrackPoint
+		expected float64
+	}{
+		{name: "no points", expected: 0},
+		{name: "single point", points: []*model.TrackPoint{point(0, 0, nil)}, expected: 0},
+		{name: "single point with reported speed", points: []*model.TrackPoint{point(0, 0, float(2))}, expected: 2},
+		{
+			name:     "two points",
+			points:   []*model.TrackPoint{point(0, 0, nil), point(0.001, 10*time.Second, nil)},
+			expected: northOf / 10,
+		},
+		{
+			name: "there and back",
+			points: []*model.TrackPoint{
+				point(0, 0, nil),
+				point(0.001, 10*time.Second, nil),
+				point(0, 20*time.Second, nil),
+			},
+			expected: northOf / 10,
+		},
+		{
+			name:     "no time between points",
+			points:   []*model.TrackPoint{point(0, 0, nil), point(0.001, 0, float(4))},
+			expected: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RecentSpeed(tt.points); !approximately(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTimeWithin(t *testing.T) {
+	destination := &model.Coordinates{Lat: 0, Lng: 0}
+
+	tests := []struct {
+		name     string
+		points   []*model.TrackPoint
+		expected time.Duration
+		within   bool
+	}{
+		{name: "no points"},
+		{
+			name:   "last point outside",
+			points: []*model.TrackPoint{point(0, 0, nil), point(0.001, 10*time.Second, nil)},
+		},
+		{
+			name:   "just arrived",
+			points: []*model.TrackPoint{point(0.001, 0, nil), point(0, 10*time.Second, nil)},
+			within: true,
+		},
+		{
+			name: "every point within",
+			points: []*model.TrackPoint{
+				point(0, 0, nil),
+				point(0.0001, 10*time.Second, nil),
+				point(0, 30*time.Second, nil),
+			},
+			expected: 30 * time.Second,
+			within:   true,
+		},
+		{
+			name: "left and returned",
+			points: []*model.TrackPoint{
+				point(0, 0, nil),
+				point(0.001, 10*time.Second, nil),
+				point(0, 20*time.Second, nil),
+				point(0.0001, 45*time.Second, nil),
+			},
+			expected: 25 * time.Second,
+			within:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, within := TimeWithin(tt.points, destination, 25)
+			if got != tt.expected || within != tt.within {
+				t.Errorf("expected %v, %v, got %v, %v", tt.expected, tt.within, got, within)
+			}
+		})
+	}
+}
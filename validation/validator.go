@@ -0,0 +1,150 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/cobbinma/track-api/geo"
+	"github.com/cobbinma/track-api/graph/model"
+	"math"
+	"time"
+)
+
+// Code identifies why a position was rejected.
+type Code string
+
+const (
+	CodeInvalidCoordinates Code = "INVALID_COORDINATES"
+	CodeInvalidTelemetry   Code = "INVALID_TELEMETRY"
+	CodeOutOfOrder         Code = "OUT_OF_ORDER"
+	CodeFutureTimestamp    Code = "FUTURE_TIMESTAMP"
+	CodeImplausibleSpeed   Code = "IMPLAUSIBLE_SPEED"
+)
+
+// minInterval is the shortest time assumed between two positions when checking the speed between them, so that
+// small jitter between positions recorded at the same instant is not rejected.
+const minInterval = time.Second
+
+// Error is a position rejected by a rule.
+type Error struct {
+	Code    Code
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Rule checks a position against the one recorded before it, which is nil for the first position of a journey.
+type Rule func(previous, next *model.Position) error
+
+// Validator runs positions through its rules in order, stopping at the first rejection.
+type Validator struct {
+	rules []Rule
+}
+
+// NewValidator returns a validator enforcing coordinate bounds, timestamps no more than maxSkew ahead of the server's
+// clock, monotonic timestamps and a maximum speed in metres per second between consecutive positions.
+func NewValidator(maxSpeed float64, maxSkew time.Duration) *Validator {
+	return &Validator{rules: []Rule{Bounds, MaxSkew(maxSkew), Monotonic, MaxSpeed(maxSpeed)}}
+}
+
+// Validate checks each position against the one before it, starting from the journey's current position.
+func (v *Validator) Validate(current *model.Position, positions ...*model.Position) error {
+	previous := current
+	for _, p := range positions {
+		for _, rule := range v.rules {
+			if err := rule(previous, p); err != nil {
+				return err
+			}
+		}
+		previous = p
+	}
+
+	return nil
+}
+
+// Bounds rejects coordinates outside ±90/±180 degrees and telemetry that cannot be measured.
+func Bounds(_, next *model.Position) error {
+	if math.IsNaN(next.Lat) || math.IsNaN(next.Lng) || !geo.ValidCoordinates(next.Lat, next.Lng) {
+		return &Error{
+			Code:    CodeInvalidCoordinates,
+			Message: fmt.Sprintf("coordinates %v, %v are out of bounds", next.Lat, next.Lng),
+		}
+	}
+
+	if a := next.Accuracy; a != nil && (math.IsNaN(*a) || *a < 0) {
+		return &Error{Code: CodeInvalidTelemetry, Message: fmt.Sprintf("accuracy %v is negative", *a)}
+	}
+
+	if s := next.Speed; s != nil && (math.IsNaN(*s) || *s < 0) {
+		return &Error{Code: CodeInvalidTelemetry, Message: fmt.Sprintf("speed %v is negative", *s)}
+	}
+
+	if h := next.Heading; h != nil && (math.IsNaN(*h) || *h < 0 || *h >= 360) {
+		return &Error{Code: CodeInvalidTelemetry, Message: fmt.Sprintf("heading %v is not within [0, 360)", *h)}
+	}
+
+	return nil
+}
+
+// MaxSkew returns a rule rejecting positions recorded further ahead of the server's clock than the given skew, which
+// would otherwise leave every later position out of order.
+func MaxSkew(skew time.Duration) Rule {
+	return func(_, next *model.Position) error {
+		if next.RecordedAt == nil {
+			return nil
+		}
+
+		if limit := time.Now().Add(skew); next.RecordedAt.After(limit) {
+			return &Error{
+				Code: CodeFutureTimestamp,
+				Message: fmt.Sprintf("position recorded at %s is more than %s ahead of the server time",
+					next.RecordedAt.Format(time.RFC3339), skew),
+			}
+		}
+
+		return nil
+	}
+}
+
+// Monotonic rejects positions recorded before the previous position.
+func Monotonic(previous, next *model.Position) error {
+	if previous == nil || previous.RecordedAt == nil || next.RecordedAt == nil {
+		return nil
+	}
+
+	if next.RecordedAt.Before(*previous.RecordedAt) {
+		return &Error{
+			Code: CodeOutOfOrder,
+			Message: fmt.Sprintf("position recorded at %s is older than the last position recorded at %s",
+				next.RecordedAt.Format(time.RFC3339), previous.RecordedAt.Format(time.RFC3339)),
+		}
+	}
+
+	return nil
+}
+
+// MaxSpeed returns a rule rejecting positions that could only be reached from the previous position by travelling
+// faster than the given speed in metres per second.
+func MaxSpeed(speed float64) Rule {
+	return func(previous, next *model.Position) error {
+		if previous == nil || previous.RecordedAt == nil || next.RecordedAt == nil {
+			return nil
+		}
+
+		interval := next.RecordedAt.Sub(*previous.RecordedAt)
+		if interval < minInterval {
+			interval = minInterval
+		}
+
+		distance := geo.Distance(previous.Lat, previous.Lng, next.Lat, next.Lng)
+		if s := distance / interval.Seconds(); s > speed {
+			return &Error{
+				Code: CodeImplausibleSpeed,
+				Message: fmt.Sprintf("travelling %.0fm in %s requires %.1fm/s, over the maximum of %.1fm/s",
+					distance, interval, s, speed),
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,138 @@
+package validation
+
+import (
+	"errors"
+	"github.com/cobbinma/track-api/graph/model"
+	"math"
+	"testing"
+	"time"
+)
+
+func position(lat, lng float64, recordedAt time.Time) *model.Position {
+	return &model.Position{Lat: lat, Lng: lng, RecordedAt: &recordedAt}
+}
+
+func float(f float64) *float64 {
+	return &f
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Now().UTC()
+	withTelemetry := func(accuracy, speed, heading *float64) *model.Position {
+		p := position(51.5, -0.12, now)
+		p.Accuracy, p.Speed, p.Heading = accuracy, speed, heading
+		return p
+	}
+
+	tests := []struct {
+		name      string
+		current   *model.Position
+		positions []*model.Position
+		code      Code
+	}{
+		{
+			name:      "first position",
+			positions: []*model.Position{position(51.5, -0.12, now)},
+		},
+		{
+			name:      "walking pace",
+			current:   position(51.5, -0.12, now.Add(-10*time.Second)),
+			positions: []*model.Position{position(51.5001, -0.12, now)},
+		},
+		{
+			name:      "without recorded times",
+			current:   &model.Position{Lat: 0, Lng: 0},
+			positions: []*model.Position{{Lat: 10, Lng: 10}},
+		},
+		{
+			name:      "latitude out of bounds",
+			positions: []*model.Position{position(91, 0, now)},
+			code:      CodeInvalidCoordinates,
+		},
+		{
+			name:      "longitude out of bounds",
+			positions: []*model.Position{position(0, -181, now)},
+			code:      CodeInvalidCoordinates,
+		},
+		{
+			name:      "not a number",
+			positions: []*model.Position{position(math.NaN(), 0, now)},
+			code:      CodeInvalidCoordinates,
+		},
+		{
+			name:      "negative accuracy",
+			positions: []*model.Position{withTelemetry(float(-1), nil, nil)},
+			code:      CodeInvalidTelemetry,
+		},
+		{
+			name:      "negative speed",
+			positions: []*model.Position{withTelemetry(nil, float(-1), nil)},
+			code:      CodeInvalidTelemetry,
+		},
+		{
+			name:      "heading of a full turn",
+			positions: []*model.Position{withTelemetry(nil, nil, float(360))},
+			code:      CodeInvalidTelemetry,
+		},
+		{
+			name:      "valid telemetry",
+			positions: []*model.Position{withTelemetry(float(5), float(1.5), float(359.9))},
+		},
+		{
+			name:      "within clock skew",
+			positions: []*model.Position{position(51.5, -0.12, now.Add(30*time.Second))},
+		},
+		{
+			name:      "beyond clock skew",
+			positions: []*model.Position{position(51.5, -0.12, now.Add(time.Hour))},
+			code:      CodeFutureTimestamp,
+		},
+		{
+			name:      "older than current",
+			current:   position(51.5, -0.12, now),
+			positions: []*model.Position{position(51.5, -0.12, now.Add(-time.Second))},
+			code:      CodeOutOfOrder,
+		},
+		{
+			name:    "out of order within batch",
+			current: position(51.5, -0.12, now.Add(-time.Minute)),
+			positions: []*model.Position{
+				position(51.5, -0.12, now),
+				position(51.5, -0.12, now.Add(-30*time.Second)),
+			},
+			code: CodeOutOfOrder,
+		},
+		{
+			name:      "too fast",
+			current:   position(51.5, -0.12, now.Add(-10*time.Second)),
+			positions: []*model.Position{position(51.6, -0.12, now)},
+			code:      CodeImplausibleSpeed,
+		},
+		{
+			name:      "jitter at the same instant",
+			current:   position(51.5, -0.12, now),
+			positions: []*model.Position{position(51.50001, -0.12, now)},
+		},
+	}
+
+	validator := NewValidator(50, time.Minute)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(tt.current, tt.positions...)
+			if tt.code == "" {
+				if err != nil {
+					t.Fatalf("expected position to be accepted, got %v", err)
+				}
+				return
+			}
+
+			var vErr *Error
+			if !errors.As(err, &vErr) {
+				t.Fatalf("expected *Error with code %s, got %v", tt.code, err)
+			}
+			if vErr.Code != tt.code {
+				t.Errorf("expected code %s, got %s", tt.code, vErr.Code)
+			}
+		})
+	}
+}